@@ -4,10 +4,19 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 
+	"github.com/lerryjay/auth-grpc-service/pkg/authz"
 	"github.com/lerryjay/auth-grpc-service/pkg/config"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/identity"
+	"github.com/lerryjay/auth-grpc-service/pkg/notify"
+	"github.com/lerryjay/auth-grpc-service/pkg/password"
 	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	"github.com/lerryjay/auth-grpc-service/pkg/rbac"
 	"github.com/lerryjay/auth-grpc-service/pkg/routes"
+	"github.com/lerryjay/auth-grpc-service/pkg/throttle"
+	"github.com/lerryjay/auth-grpc-service/pkg/webauthn"
 
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/reflection"
@@ -24,9 +33,70 @@ func main() {
 		log.Fatalln("Failed to serve:", err)
 	}
 
+	jwtManager, err := helpers.NewJWTManager(config)
+	if err != nil {
+		log.Fatalln("Failed to load JWT signing keys:", err)
+	}
+
+	encryptionKey, err := helpers.DecodeEncryptionKey(config.DATA_ENCRYPTION_KEY)
+	if err != nil {
+		log.Fatalln("Invalid DATA_ENCRYPTION_KEY:", err)
+	}
+
+	passwordPolicy, err := password.NewPolicy(config)
+	if err != nil {
+		log.Fatalln("Failed to load password policy:", err)
+	}
+
+	passwordHasher, err := password.NewHasher(config)
+	if err != nil {
+		log.Fatalln("Invalid password hashing config:", err)
+	}
+
+	notifier, err := notify.New(config)
+	if err != nil {
+		log.Fatalln("Invalid notification config:", err)
+	}
+
+	relyingParty, err := webauthn.New(config)
+	if err != nil {
+		log.Println("Passkeys disabled:", err)
+	}
+
+	catalogue, err := rbac.Load(config)
+	if err != nil {
+		log.Fatalln("Failed to load RBAC config:", err)
+	}
+
 	dbUrl := fmt.Sprintf("postgres://%s:%s@%s", config.DBUSER, config.DBPWD, config.DBURL)
 	log.Println("Database Url", dbUrl)
 	handler := routes.Init(dbUrl, config.CLIENT_ID, config.SECRET_KEY, config.TOKEN_URL, config.QOREID_BASE_URL, config.VNIN_URL, config.NIN_URL, config.DL_URL, config.PASSPORT_URL, config.BIOMETRIC_QOREID_BASE_URL)
+	if err := rbac.Seed(handler.DB, catalogue); err != nil {
+		log.Fatalln("Failed to seed roles and permissions:", err)
+	}
+
+	var throttleStore throttle.Store
+	switch config.THROTTLE_STORE {
+	case "postgres":
+		throttleStore = throttle.NewPostgresStore(handler.DB)
+	case "memory", "":
+		throttleStore = throttle.NewMemoryStore(config.LOCKOUT_WINDOW + config.LOCKOUT_DURATION)
+	default:
+		log.Fatalln("Unknown THROTTLE_STORE:", config.THROTTLE_STORE)
+	}
+
+	switch config.LOGIN_REQUIRE_VERIFIED {
+	case routes.RequireVerifiedNone, routes.RequireVerifiedEmail, routes.RequireVerifiedPhone, routes.RequireVerifiedAny, routes.RequireVerifiedAll:
+	default:
+		log.Fatalln("Unknown LOGIN_REQUIRE_VERIFIED:", config.LOGIN_REQUIRE_VERIFIED)
+	}
+
+	switch config.STALE_PERMISSION_CLAIMS {
+	case routes.StalePermissionsReject, routes.StalePermissionsFlag:
+	default:
+		log.Fatalln("Unknown STALE_PERMISSION_CLAIMS:", config.STALE_PERMISSION_CLAIMS)
+	}
+
 	h := routes.Handler{
 		DB:                     handler.DB,
 		ClientID:               config.CLIENT_ID,
@@ -38,9 +108,148 @@ func main() {
 		DLURL:                  config.DL_URL,
 		PassportURL:            config.PASSPORT_URL,
 		BiometricQoreidBaseURL: config.BIOMETRIC_QOREID_BASE_URL,
+		JWT:                    jwtManager,
+		RefreshTokenTTL:        config.REFRESH_TOKEN_TTL,
+		AppName:                config.APP_NAME,
+		EncryptionKey:          encryptionKey,
+		Notifier:               notifier,
+		OTPTTL:                 config.OTP_TTL,
+		OTPMaxAttempts:         config.OTP_MAX_ATTEMPTS,
+		PasswordPolicy:         passwordPolicy,
+		Hasher:                 passwordHasher,
+		LoginRequireVerified:   config.LOGIN_REQUIRE_VERIFIED,
+		Identity:               identity.NewRegistry(config),
+		PasswordlessLinkURL:    config.PASSWORDLESS_LINK_URL,
+		WebAuthn:               relyingParty,
+		DefaultRole:            catalogue.DefaultRole,
+		PermissionClaims:       config.TOKEN_PERMISSION_CLAIMS,
+		StalePermissions:       config.STALE_PERMISSION_CLAIMS,
+		Throttle:               throttle.New(throttleStore, config),
+		OIDCLoginURL:           config.OIDC_LOGIN_URL,
+		AuthorizationCodeTTL:   config.AUTHORIZATION_CODE_TTL,
+	}
+
+	// Who may call each RPC. Every method of a registered service has to be
+	// listed here, so new RPCs can't be added without a policy.
+	public := authz.Public()
+	self := authz.Self()
+	policies := authz.Policies{
+		"/grpc.reflection.v1alpha.ServerReflection/*": public,
+	}
+	servicePolicies := []struct {
+		desc     grpc.ServiceDesc
+		policies map[string]authz.Policy
+	}{
+		{pb.UserService_ServiceDesc, map[string]authz.Policy{
+			"ListUsers":                   authz.Permission("users.read"),
+			"GetUser":                     authz.SelfOrPermission("users.read"),
+			"CreateUser":                  public,
+			"UpdateUser":                  authz.SelfOrPermission("users.write"),
+			"DeleteUser":                  authz.SelfOrPermission("users.delete"),
+			"UpdateUserIDImage":           authz.SelfOrPermission("users.write"),
+			"UpdateUserIDNumber":          authz.Permission("users.write"),
+			"UpdateUserSelfie":            authz.SelfOrPermission("users.write"),
+			"VerifyUser":                  authz.SelfOrPermission("users.write"),
+			"UpdateUserIDType":            authz.Permission("users.write"),
+			"UpdateUserProfilePicture":    authz.SelfOrPermission("users.write"),
+			"UpdateUserAddress":           authz.SelfOrPermission("users.write"),
+			"UpdateUserVerificationNames": authz.Permission("users.write"),
+			"GetUserAddress":              authz.SelfOrPermission("users.read"),
+			"GetUserStats":                authz.Permission("users.read"),
+			"UpdateUserHostingStatus":     authz.SelfOrPermission("users.write"),
+			"SendEmailVerification":       public,
+			"ConfirmEmail":                public,
+			"SendPhoneVerification":       public,
+			"ConfirmPhone":                public,
+		}},
+		{pb.AuthService_ServiceDesc, map[string]authz.Policy{
+			"LoginUser":                 public,
+			"SocialLogin":               public,
+			"ChangePassword":            self,
+			"ForgotPassword":            public,
+			"ResetPassword":             public,
+			"ValidateToken":             public,
+			"VerifyOTP":                 public,
+			"HasPermission":             authz.SelfOrPermission("roles.read"),
+			"CheckPermissions":          authz.SelfOrPermission("roles.read"),
+			"ListUserPermissions":       authz.SelfOrPermission("roles.read"),
+			"AddUserPermission":         authz.Permission("roles.assign"),
+			"DeleteUserPermission":      authz.Permission("roles.assign"),
+			"UpdateUserPermissions":     authz.Permission("roles.assign"),
+			"CheckUserPasswordStatus":   authz.SelfOrPermission("users.read"),
+			"GetJWKS":                   public,
+			"RefreshToken":              public,
+			"ListSessions":              authz.SelfOrPermission("sessions.read"),
+			"RevokeSession":             authz.SelfOrPermission("sessions.revoke"),
+			"RevokeAllSessions":         authz.SelfOrPermission("sessions.revoke"),
+			"Logout":                    public,
+			"EnrollTOTP":                self,
+			"ConfirmTOTP":               self,
+			"DisableTOTP":               self,
+			"VerifyMFA":                 public,
+			"RegenerateRecoveryCodes":   self,
+			"UnlockAccount":             authz.Permission("users.unlock"),
+			"LinkIdentity":              self,
+			"UnlinkIdentity":            self,
+			"ListLinkedIdentities":      self,
+			"StartPasswordlessLogin":    public,
+			"CompletePasswordlessLogin": public,
+			"BeginPasskeyRegistration":  self,
+			"FinishPasskeyRegistration": self,
+			"BeginPasskeyLogin":         public,
+			"FinishPasskeyLogin":        public,
+			"ListPasskeys":              self,
+			"DeletePasskey":             self,
+			"ListPermissions":           authz.Permission("roles.read"),
+			"CreateRole":                authz.Permission("roles.write"),
+			"GetRole":                   authz.Permission("roles.read"),
+			"ListRoles":                 authz.Permission("roles.read"),
+			"UpdateRole":                authz.Permission("roles.write"),
+			"DeleteRole":                authz.Permission("roles.write"),
+			"AssignUserRole":            authz.Permission("roles.assign"),
+			"RemoveUserRole":            authz.Permission("roles.assign"),
+			"ListUserRoles":             authz.SelfOrPermission("roles.read"),
+			"ClientToken":               public,
+			"CreateClient":              authz.Permission("clients.write"),
+			"ListClients":               authz.Permission("clients.read"),
+			"RotateClientSecret":        authz.Permission("clients.write"),
+			"DisableClient":             authz.Permission("clients.write"),
+			"UpdateClient":              authz.Permission("clients.write"),
+			"Authorize":                 authz.Authenticated(),
+			"IntrospectToken":           authz.Permission("tokens.introspect"),
+			"RevokeToken":               authz.Authenticated().WithAPIKeys(),
+			"CreateAPIKey":              self,
+			"ListAPIKeys":               authz.SelfOrPermission("users.read"),
+			"RevokeAPIKey":              authz.SelfOrPermission("sessions.revoke"),
+		}},
+		{pb.TestService_ServiceDesc, map[string]authz.Policy{
+			"SayingHello": public,
+		}},
+		// Paid lookups with the service's QoreID credentials. VerifyUser
+		// calls them in process for users verifying themselves.
+		{pb.VerificationService_ServiceDesc, map[string]authz.Policy{
+			"VerifyNIN":      authz.Permission("verifications.run"),
+			"VerifyVNIN":     authz.Permission("verifications.run"),
+			"VerifyDL":       authz.Permission("verifications.run"),
+			"VerifyPassport": authz.Permission("verifications.run"),
+			"Login":          authz.Permission("verifications.run"),
+			"VerifyIDImage":  authz.Permission("verifications.run"),
+		}},
+		{pb.Health_ServiceDesc, map[string]authz.Policy{
+			"Check": public,
+		}},
+	}
+	for _, s := range servicePolicies {
+		if err := policies.Add(s.desc, s.policies); err != nil {
+			log.Fatalln("Invalid access policies:", err)
+		}
 	}
 
-	grpcServer := grpc.NewServer()
+	interceptor := authz.NewInterceptor(policies, h.Authenticator())
+	grpcServer := grpc.NewServer(
+		grpc.UnaryInterceptor(interceptor.Unary()),
+		grpc.StreamInterceptor(interceptor.Stream()),
+	)
 	pb.RegisterUserServiceServer(grpcServer, &h)
 	pb.RegisterAuthServiceServer(grpcServer, &h)
 	pb.RegisterTestServiceServer(grpcServer, &h)
@@ -49,6 +258,12 @@ func main() {
 
 	reflection.Register(grpcServer)
 
+	go func() {
+		if err := http.ListenAndServe(config.HTTP_PORT, h.HTTPHandler()); err != nil {
+			log.Fatalln("Failed to serve HTTP:", err)
+		}
+	}()
+
 	if err := grpcServer.Serve(lis); err != nil {
 		log.Fatalln("Failed to serve:", err)
 	}
@@ -0,0 +1,209 @@
+package authz
+
+import (
+	"context"
+	"log"
+	"strings"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/rbac"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Identity is the authenticated caller, either a user or an OAuth2 client
+type Identity struct {
+	UserID    string
+	SessionID string
+	// Set for service tokens, which carry the scopes granted to the client
+	// instead of acting as a user
+	ClientID string
+	// Set when a user authenticated with an API key, which acts as the user
+	// but only within its scopes
+	APIKeyID string
+	Scopes   []string
+}
+
+// HasScope reports whether one of the caller's scopes covers the permission
+func (c *Identity) HasScope(permission string) bool {
+	for _, scope := range c.Scopes {
+		if rbac.Match(scope, permission) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Identity) String() string {
+	if c.ClientID != "" {
+		return "client " + c.ClientID
+	}
+	return c.UserID
+}
+
+type identityKey struct{}
+
+// WithCaller returns a context carrying the caller's identity
+func WithCaller(ctx context.Context, caller *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, caller)
+}
+
+// Caller returns the identity the interceptor authenticated, if any
+func Caller(ctx context.Context) (*Identity, bool) {
+	caller, ok := ctx.Value(identityKey{}).(*Identity)
+	return caller, ok
+}
+
+// BearerToken returns the token in the authorization metadata, if any
+func BearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	values := md.Get("authorization")
+	if len(values) == 0 {
+		return ""
+	}
+	auth := values[0]
+	if len(auth) > 7 && strings.EqualFold(auth[:7], "bearer ") {
+		return strings.TrimSpace(auth[7:])
+	}
+	return ""
+}
+
+// Authenticator validates access tokens and answers permission checks
+type Authenticator interface {
+	// Authenticate returns the identity a token was issued to, or a status
+	// error explaining why it isn't valid
+	Authenticate(ctx context.Context, token string) (*Identity, error)
+	// Authorize reports whether the caller holds the permission
+	Authorize(ctx context.Context, caller *Identity, permission string) (bool, error)
+}
+
+// Interceptor enforces Policies on every call
+type Interceptor struct {
+	policies Policies
+	auth     Authenticator
+}
+
+func NewInterceptor(policies Policies, auth Authenticator) *Interceptor {
+	return &Interceptor{policies: policies, auth: auth}
+}
+
+// Unary returns the interceptor for unary RPCs
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		policy, ctx, err := i.authenticate(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		if err := i.authorize(ctx, policy, info.FullMethod, req); err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Stream returns the interceptor for streaming RPCs. Self policies are
+// checked against every message the client sends.
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		policy, ctx, err := i.authenticate(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		if !policy.self {
+			if err := i.authorize(ctx, policy, info.FullMethod, nil); err != nil {
+				return err
+			}
+		}
+		return handler(srv, &stream{ServerStream: ss, ctx: ctx, policy: policy, method: info.FullMethod, i: i})
+	}
+}
+
+// authenticate looks up the method's policy and validates the caller's
+// token, adding their identity to the context
+func (i *Interceptor) authenticate(ctx context.Context, method string) (Policy, context.Context, error) {
+	policy, ok := i.policies.Lookup(method)
+	if !ok {
+		log.Println("No access policy for", method)
+		return policy, ctx, status.Errorf(codes.PermissionDenied, codes.PermissionDenied.String())
+	}
+
+	token := BearerToken(ctx)
+	if token == "" {
+		if policy.public {
+			return policy, ctx, nil
+		}
+		return policy, ctx, status.Errorf(codes.Unauthenticated, "Authentication required")
+	}
+
+	caller, err := i.auth.Authenticate(ctx, token)
+	if err != nil {
+		if policy.public {
+			// A stale token shouldn't stop someone from logging in again
+			return policy, ctx, nil
+		}
+		return policy, ctx, err
+	}
+	return policy, WithCaller(ctx, caller), nil
+}
+
+// authorize applies the policy to a request
+func (i *Interceptor) authorize(ctx context.Context, policy Policy, method string, req interface{}) error {
+	if policy.public {
+		return nil
+	}
+	caller, ok := Caller(ctx)
+	if !ok {
+		return status.Errorf(codes.Unauthenticated, "Authentication required")
+	}
+
+	// Clients don't act as a user, so self policies only let them through
+	// with a scope covering the permission. API keys act for their user, but
+	// only on methods whose permission their scopes cover, or that opted in
+	// with WithAPIKeys.
+	if policy.self && req != nil && caller.UserID != "" && subject(req) == caller.UserID {
+		if caller.APIKeyID == "" || (policy.permission != "" && caller.HasScope(policy.permission)) {
+			return nil
+		}
+	}
+	if policy.permission != "" {
+		allowed, err := i.auth.Authorize(ctx, caller, policy.permission)
+		if err != nil {
+			log.Println("Error checking permission for", method, err)
+			return status.Errorf(codes.Internal, "An unexpected error occurred")
+		}
+		if allowed {
+			return nil
+		}
+	} else if !policy.self && (caller.APIKeyID == "" || policy.apiKeys) {
+		return nil
+	}
+
+	log.Println("Denied", method, "to", caller, "policy", policy)
+	return status.Errorf(codes.PermissionDenied, codes.PermissionDenied.String())
+}
+
+type stream struct {
+	grpc.ServerStream
+	ctx    context.Context
+	policy Policy
+	method string
+	i      *Interceptor
+}
+
+func (s *stream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *stream) RecvMsg(m interface{}) error {
+	if err := s.ServerStream.RecvMsg(m); err != nil {
+		return err
+	}
+	if s.policy.self {
+		return s.i.authorize(s.ctx, s.policy, s.method, m)
+	}
+	return nil
+}
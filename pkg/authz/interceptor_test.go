@@ -0,0 +1,135 @@
+package authz
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// fakeAuth knows a fixed set of tokens. Users hold the permissions in
+// grants, clients hold their scopes and API keys hold what both their scopes
+// and their user allow.
+type fakeAuth struct {
+	tokens map[string]*Identity
+	grants map[string][]string
+}
+
+func (a *fakeAuth) Authenticate(ctx context.Context, token string) (*Identity, error) {
+	caller, ok := a.tokens[token]
+	if !ok {
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid token")
+	}
+	return caller, nil
+}
+
+func (a *fakeAuth) Authorize(ctx context.Context, caller *Identity, permission string) (bool, error) {
+	if caller.ClientID != "" {
+		return caller.HasScope(permission), nil
+	}
+	if caller.APIKeyID != "" && !caller.HasScope(permission) {
+		return false, nil
+	}
+	for _, grant := range a.grants[caller.UserID] {
+		if grant == permission {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestInterceptorUnary(t *testing.T) {
+	auth := &fakeAuth{
+		tokens: map[string]*Identity{
+			"user":       {UserID: "alice", SessionID: "s1"},
+			"key":        {UserID: "alice", APIKeyID: "k1", Scopes: []string{"users.read"}},
+			"key-none":   {UserID: "alice", APIKeyID: "k2"},
+			"key-wide":   {UserID: "alice", APIKeyID: "k3", Scopes: []string{"users.*"}},
+			"client":     {ClientID: "c1", Scopes: []string{"users.read"}},
+			"client-all": {ClientID: "c2", Scopes: []string{"*"}},
+		},
+		grants: map[string][]string{
+			"alice": {"users.read"},
+		},
+	}
+
+	policies := Policies{
+		"/test.Service/Public":        Public(),
+		"/test.Service/Authenticated": Authenticated(),
+		"/test.Service/APIKeys":       Authenticated().WithAPIKeys(),
+		"/test.Service/Self":          Self(),
+		"/test.Service/Read":          Permission("users.read"),
+		"/test.Service/Write":         Permission("users.write"),
+		"/test.Service/SelfOrRead":    SelfOrPermission("users.read"),
+		"/test.Service/SelfOrRevoke":  SelfOrPermission("sessions.revoke"),
+	}
+
+	tests := []struct {
+		name   string
+		method string
+		token  string
+		userID string
+		want   codes.Code
+	}{
+		{"public without a token", "Public", "", "", codes.OK},
+		{"public with an invalid token", "Public", "bad", "", codes.OK},
+		{"public with an API key", "Public", "key-none", "", codes.OK},
+		{"unknown method", "Missing", "user", "", codes.PermissionDenied},
+
+		{"authenticated without a token", "Authenticated", "", "", codes.Unauthenticated},
+		{"authenticated with an invalid token", "Authenticated", "bad", "", codes.Unauthenticated},
+		{"authenticated user", "Authenticated", "user", "", codes.OK},
+		{"authenticated client", "Authenticated", "client", "", codes.OK},
+		{"authenticated API key", "Authenticated", "key", "", codes.PermissionDenied},
+		{"authenticated API key without scopes", "Authenticated", "key-none", "", codes.PermissionDenied},
+		{"authenticated API key with all scopes", "Authenticated", "key-wide", "", codes.PermissionDenied},
+		{"allowed API key without scopes", "APIKeys", "key-none", "", codes.OK},
+		{"allowed API key user", "APIKeys", "user", "", codes.OK},
+
+		{"self user", "Self", "user", "alice", codes.OK},
+		{"self user on another user", "Self", "user", "bob", codes.PermissionDenied},
+		{"self user without a subject", "Self", "user", "", codes.PermissionDenied},
+		{"self API key", "Self", "key", "alice", codes.PermissionDenied},
+		{"self API key with all scopes", "Self", "key-wide", "alice", codes.PermissionDenied},
+		{"self client", "Self", "client-all", "alice", codes.PermissionDenied},
+
+		{"permission user", "Read", "user", "bob", codes.OK},
+		{"permission user without the grant", "Write", "user", "alice", codes.PermissionDenied},
+		{"permission API key with the scope", "Read", "key", "bob", codes.OK},
+		{"permission API key without scopes", "Read", "key-none", "bob", codes.PermissionDenied},
+		{"permission API key beyond its user", "Write", "key-wide", "alice", codes.PermissionDenied},
+		{"permission client with the scope", "Read", "client", "", codes.OK},
+		{"permission client without the scope", "Write", "client", "", codes.PermissionDenied},
+
+		{"self or permission user on self", "SelfOrRevoke", "user", "alice", codes.OK},
+		{"self or permission user on another user", "SelfOrRevoke", "user", "bob", codes.PermissionDenied},
+		{"self or permission API key with the scope", "SelfOrRead", "key", "alice", codes.OK},
+		{"self or permission API key without the scope", "SelfOrRevoke", "key", "alice", codes.PermissionDenied},
+		{"self or permission API key without scopes", "SelfOrRevoke", "key-none", "alice", codes.PermissionDenied},
+		{"self or permission client with the scope", "SelfOrRead", "client", "bob", codes.OK},
+		{"self or permission client without the scope", "SelfOrRevoke", "client", "alice", codes.PermissionDenied},
+	}
+
+	interceptor := NewInterceptor(policies, auth).Unary()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tt.token))
+			}
+			info := &grpc.UnaryServerInfo{FullMethod: "/test.Service/" + tt.method}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return "ok", nil
+			}
+
+			_, err := interceptor(ctx, &pb.ListAPIKeysRequest{UserId: tt.userID}, info, handler)
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("got %v, want %v (%v)", got, tt.want, err)
+			}
+		})
+	}
+}
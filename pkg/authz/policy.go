@@ -0,0 +1,142 @@
+// Package authz authenticates gRPC calls and enforces a per method access
+// policy table
+package authz
+
+import (
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// selfFields are the request fields naming the user a call acts on
+var selfFields = []string{"UserId", "Id"}
+
+// Policy says who may call a method
+type Policy struct {
+	public     bool
+	self       bool
+	permission string
+	apiKeys    bool
+}
+
+// Public lets anyone call the method. A bearer token is still read if one
+// is sent, so the handler can see the caller.
+func Public() Policy {
+	return Policy{public: true}
+}
+
+// Authenticated requires a valid access token. API keys are refused, since
+// there is no permission to check their scopes against, unless the policy
+// is made WithAPIKeys.
+func Authenticated() Policy {
+	return Policy{}
+}
+
+// Self requires the caller to be the user named in the request's UserId or
+// Id field
+func Self() Policy {
+	return Policy{self: true}
+}
+
+// Permission requires the caller to hold the permission
+func Permission(permission string) Policy {
+	return Policy{permission: permission}
+}
+
+// SelfOrPermission lets users act on themselves, and callers holding the
+// permission act on anyone
+func SelfOrPermission(permission string) Policy {
+	return Policy{self: true, permission: permission}
+}
+
+// WithAPIKeys lets API keys call an Authenticated method whose handler
+// decides what the key may do
+func (p Policy) WithAPIKeys() Policy {
+	p.apiKeys = true
+	return p
+}
+
+func (p Policy) String() string {
+	switch {
+	case p.public:
+		return "public"
+	case p.self && p.permission != "":
+		return "self or " + p.permission
+	case p.self:
+		return "self"
+	case p.permission != "":
+		return p.permission
+	case p.apiKeys:
+		return "authenticated or API key"
+	default:
+		return "authenticated"
+	}
+}
+
+// Policies maps full method names, /package.Service/Method, to their
+// policy. /package.Service/* covers every method of a service.
+type Policies map[string]Policy
+
+// Add sets the policies for a service's methods. Every method of the
+// service must be listed, so adding an RPC without deciding who may call it
+// fails at startup.
+func (p Policies) Add(desc grpc.ServiceDesc, methods map[string]Policy) error {
+	names := make(map[string]bool)
+	for _, m := range desc.Methods {
+		names[m.MethodName] = true
+	}
+	for _, s := range desc.Streams {
+		names[s.StreamName] = true
+	}
+
+	for name := range names {
+		if _, ok := methods[name]; !ok {
+			return fmt.Errorf("no access policy for %s/%s", desc.ServiceName, name)
+		}
+	}
+	for name, policy := range methods {
+		if !names[name] {
+			return fmt.Errorf("access policy for unknown method %s/%s", desc.ServiceName, name)
+		}
+		p["/"+desc.ServiceName+"/"+name] = policy
+	}
+	return nil
+}
+
+// Lookup finds the policy for a full method name
+func (p Policies) Lookup(fullMethod string) (Policy, bool) {
+	if policy, ok := p[fullMethod]; ok {
+		return policy, true
+	}
+	if i := strings.LastIndex(fullMethod, "/"); i > 0 {
+		policy, ok := p[fullMethod[:i]+"/*"]
+		return policy, ok
+	}
+	return Policy{}, false
+}
+
+// subject returns the user id a request acts on, read from its UserId or Id
+// field
+func subject(req interface{}) string {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return ""
+	}
+	m := msg.ProtoReflect()
+	fields := m.Descriptor().Fields()
+	for _, name := range selfFields {
+		for i := 0; i < fields.Len(); i++ {
+			fd := fields.Get(i)
+			if fd.Kind() == protoreflect.StringKind && fd.Cardinality() != protoreflect.Repeated &&
+				strings.EqualFold(string(fd.Name()), name) {
+				if value := m.Get(fd).String(); value != "" {
+					return value
+				}
+			}
+		}
+	}
+	return ""
+}
@@ -1,26 +1,90 @@
 package config
 
 import (
+	"time"
+
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	Port                      string `mapstructure:"AUTH_SVC_PORT"`
-	DBURL                     string `mapstructure:"AUTH_DB_URL"`
-	DBUSER                    string `mapstructure:"AUTH_DB_USER"`
-	DBPWD                     string `mapstructure:"AUTH_DB_PWD"`
-	JWT_SECRET                string `mapstructure:"JWT_SECRET"`
-	APP_NAME                  string `mapstructure:"APP_NAME"`
-	APP_URL                   string `mapstructure:"APP_URL"`
-	CLIENT_ID                 string `mapstructure:"CLIENT_ID"`
-	SECRET_KEY                string `mapstructure:"SECRET_KEY"`
-	TOKEN_URL                 string `mapstructure:"TOKEN_URL"`
-	QOREID_BASE_URL           string `mapstructure:"QOREID_BASE_URL"`
-	VNIN_URL                  string `mapstructure:"VNIN_URL"`
-	NIN_URL                   string `mapstructure:"NIN_URL"`
-	DL_URL                    string `mapstructure:"DL_URL"`
-	PASSPORT_URL              string `mapstructure:"PASSPORT_URL"`
-	BIOMETRIC_QOREID_BASE_URL string `mapstructure:"BIOMETRIC_QOREID_BASE_URL"`
+	Port                        string        `mapstructure:"AUTH_SVC_PORT"`
+	DBURL                       string        `mapstructure:"AUTH_DB_URL"`
+	DBUSER                      string        `mapstructure:"AUTH_DB_USER"`
+	DBPWD                       string        `mapstructure:"AUTH_DB_PWD"`
+	JWT_SECRET                  string        `mapstructure:"JWT_SECRET"`
+	APP_NAME                    string        `mapstructure:"APP_NAME"`
+	APP_URL                     string        `mapstructure:"APP_URL"`
+	CLIENT_ID                   string        `mapstructure:"CLIENT_ID"`
+	SECRET_KEY                  string        `mapstructure:"SECRET_KEY"`
+	TOKEN_URL                   string        `mapstructure:"TOKEN_URL"`
+	QOREID_BASE_URL             string        `mapstructure:"QOREID_BASE_URL"`
+	VNIN_URL                    string        `mapstructure:"VNIN_URL"`
+	NIN_URL                     string        `mapstructure:"NIN_URL"`
+	DL_URL                      string        `mapstructure:"DL_URL"`
+	PASSPORT_URL                string        `mapstructure:"PASSPORT_URL"`
+	BIOMETRIC_QOREID_BASE_URL   string        `mapstructure:"BIOMETRIC_QOREID_BASE_URL"`
+	HTTP_PORT                   string        `mapstructure:"AUTH_HTTP_PORT"`
+	JWT_PRIVATE_KEY_PATH        string        `mapstructure:"JWT_PRIVATE_KEY_PATH"`
+	JWT_KEY_ID                  string        `mapstructure:"JWT_KEY_ID"`
+	JWT_EPHEMERAL_KEY           bool          `mapstructure:"JWT_EPHEMERAL_KEY"`
+	JWT_PUBLIC_KEYS_PATH        string        `mapstructure:"JWT_PUBLIC_KEYS_PATH"`
+	JWT_CLOCK_SKEW              time.Duration `mapstructure:"JWT_CLOCK_SKEW"`
+	ACCESS_TOKEN_TTL            time.Duration `mapstructure:"ACCESS_TOKEN_TTL"`
+	REFRESH_TOKEN_TTL           time.Duration `mapstructure:"REFRESH_TOKEN_TTL"`
+	MFA_TOKEN_TTL               time.Duration `mapstructure:"MFA_TOKEN_TTL"`
+	CLIENT_TOKEN_TTL            time.Duration `mapstructure:"CLIENT_TOKEN_TTL"`
+	DATA_ENCRYPTION_KEY         string        `mapstructure:"DATA_ENCRYPTION_KEY"`
+	SMTP_HOST                   string        `mapstructure:"SMTP_HOST"`
+	SMTP_PORT                   string        `mapstructure:"SMTP_PORT"`
+	SMTP_USERNAME               string        `mapstructure:"SMTP_USERNAME"`
+	SMTP_PASSWORD               string        `mapstructure:"SMTP_PASSWORD"`
+	SMTP_FROM                   string        `mapstructure:"SMTP_FROM"`
+	SMS_GATEWAY_URL             string        `mapstructure:"SMS_GATEWAY_URL"`
+	SMS_API_KEY                 string        `mapstructure:"SMS_API_KEY"`
+	SMS_SENDER                  string        `mapstructure:"SMS_SENDER"`
+	NOTIFY_DRIVER               string        `mapstructure:"NOTIFY_DRIVER"`
+	NOTIFY_FILE_PATH            string        `mapstructure:"NOTIFY_FILE_PATH"`
+	OTP_TTL                     time.Duration `mapstructure:"OTP_TTL"`
+	OTP_MAX_ATTEMPTS            int32         `mapstructure:"OTP_MAX_ATTEMPTS"`
+	PASSWORD_MIN_LENGTH         int           `mapstructure:"PASSWORD_MIN_LENGTH"`
+	PASSWORD_MAX_LENGTH         int           `mapstructure:"PASSWORD_MAX_LENGTH"`
+	PASSWORD_REQUIRE_UPPER      bool          `mapstructure:"PASSWORD_REQUIRE_UPPER"`
+	PASSWORD_REQUIRE_LOWER      bool          `mapstructure:"PASSWORD_REQUIRE_LOWER"`
+	PASSWORD_REQUIRE_DIGIT      bool          `mapstructure:"PASSWORD_REQUIRE_DIGIT"`
+	PASSWORD_REQUIRE_SYMBOL     bool          `mapstructure:"PASSWORD_REQUIRE_SYMBOL"`
+	PASSWORD_DISALLOW_USER_INFO bool          `mapstructure:"PASSWORD_DISALLOW_USER_INFO"`
+	PASSWORD_BREACHED_LIST_PATH string        `mapstructure:"PASSWORD_BREACHED_LIST_PATH"`
+	PASSWORD_HISTORY_SIZE       int           `mapstructure:"PASSWORD_HISTORY_SIZE"`
+	THROTTLE_STORE              string        `mapstructure:"THROTTLE_STORE"`
+	THROTTLE_FREE_ATTEMPTS      int           `mapstructure:"THROTTLE_FREE_ATTEMPTS"`
+	THROTTLE_BASE_DELAY         time.Duration `mapstructure:"THROTTLE_BASE_DELAY"`
+	THROTTLE_MAX_DELAY          time.Duration `mapstructure:"THROTTLE_MAX_DELAY"`
+	THROTTLE_IP_MAX_FAILURES    int           `mapstructure:"THROTTLE_IP_MAX_FAILURES"`
+	LOCKOUT_MAX_FAILURES        int           `mapstructure:"LOCKOUT_MAX_FAILURES"`
+	LOCKOUT_WINDOW              time.Duration `mapstructure:"LOCKOUT_WINDOW"`
+	LOCKOUT_DURATION            time.Duration `mapstructure:"LOCKOUT_DURATION"`
+	PASSWORD_HASH_ALGORITHM     string        `mapstructure:"PASSWORD_HASH_ALGORITHM"`
+	BCRYPT_COST                 int           `mapstructure:"BCRYPT_COST"`
+	ARGON2_MEMORY               uint32        `mapstructure:"ARGON2_MEMORY"`
+	ARGON2_ITERATIONS           uint32        `mapstructure:"ARGON2_ITERATIONS"`
+	ARGON2_PARALLELISM          uint8         `mapstructure:"ARGON2_PARALLELISM"`
+	LOGIN_REQUIRE_VERIFIED      string        `mapstructure:"LOGIN_REQUIRE_VERIFIED"`
+	GOOGLE_CLIENT_IDS           string        `mapstructure:"GOOGLE_CLIENT_IDS"`
+	GOOGLE_JWKS_URL             string        `mapstructure:"GOOGLE_JWKS_URL"`
+	APPLE_CLIENT_IDS            string        `mapstructure:"APPLE_CLIENT_IDS"`
+	APPLE_JWKS_URL              string        `mapstructure:"APPLE_JWKS_URL"`
+	FACEBOOK_APP_IDS            string        `mapstructure:"FACEBOOK_APP_IDS"`
+	FACEBOOK_JWKS_URL           string        `mapstructure:"FACEBOOK_JWKS_URL"`
+	JWKS_CACHE_TTL              time.Duration `mapstructure:"JWKS_CACHE_TTL"`
+	PASSWORDLESS_LINK_URL       string        `mapstructure:"PASSWORDLESS_LINK_URL"`
+	OIDC_LOGIN_URL              string        `mapstructure:"OIDC_LOGIN_URL"`
+	AUTHORIZATION_CODE_TTL      time.Duration `mapstructure:"AUTHORIZATION_CODE_TTL"`
+	WEBAUTHN_RP_ID              string        `mapstructure:"WEBAUTHN_RP_ID"`
+	WEBAUTHN_ORIGINS            string        `mapstructure:"WEBAUTHN_ORIGINS"`
+	WEBAUTHN_TIMEOUT            time.Duration `mapstructure:"WEBAUTHN_TIMEOUT"`
+	RBAC_CONFIG_PATH            string        `mapstructure:"RBAC_CONFIG_PATH"`
+	TOKEN_PERMISSION_CLAIMS     bool          `mapstructure:"TOKEN_PERMISSION_CLAIMS"`
+	STALE_PERMISSION_CLAIMS     string        `mapstructure:"STALE_PERMISSION_CLAIMS"`
 }
 
 func LoadConfig() (config Config, err error) {
@@ -29,6 +93,7 @@ func LoadConfig() (config Config, err error) {
 	viper.SetConfigType("env")
 
 	viper.AutomaticEnv()
+	setDefaults()
 
 	err = viper.ReadInConfig()
 
@@ -45,3 +110,86 @@ func LoadConfig() (config Config, err error) {
 
 	return config, err
 }
+
+// setDefaults registers every optional key so viper picks up values that are
+// only set in the environment and not in config.env
+func setDefaults() {
+	viper.SetDefault("AUTH_HTTP_PORT", ":8080")
+	viper.SetDefault("JWT_PRIVATE_KEY_PATH", "")
+	viper.SetDefault("JWT_KEY_ID", "")
+	// Development only: sign with a key generated at startup when
+	// JWT_PRIVATE_KEY_PATH is unset
+	viper.SetDefault("JWT_EPHEMERAL_KEY", false)
+	viper.SetDefault("JWT_PUBLIC_KEYS_PATH", "")
+	viper.SetDefault("JWT_CLOCK_SKEW", "30s")
+	viper.SetDefault("ACCESS_TOKEN_TTL", "15m")
+	viper.SetDefault("REFRESH_TOKEN_TTL", "720h")
+	viper.SetDefault("MFA_TOKEN_TTL", "5m")
+	viper.SetDefault("CLIENT_TOKEN_TTL", "1h")
+	viper.SetDefault("DATA_ENCRYPTION_KEY", "")
+	viper.SetDefault("SMTP_HOST", "")
+	viper.SetDefault("SMTP_PORT", "587")
+	viper.SetDefault("SMTP_USERNAME", "")
+	viper.SetDefault("SMTP_PASSWORD", "")
+	viper.SetDefault("SMTP_FROM", "")
+	viper.SetDefault("SMS_GATEWAY_URL", "")
+	viper.SetDefault("SMS_API_KEY", "")
+	viper.SetDefault("SMS_SENDER", "")
+	// Set to file to write messages for channels without a provider to
+	// NOTIFY_FILE_PATH. Development only: codes are written in plain text.
+	viper.SetDefault("NOTIFY_DRIVER", "")
+	viper.SetDefault("NOTIFY_FILE_PATH", "notifications.log")
+	viper.SetDefault("OTP_TTL", "10m")
+	viper.SetDefault("OTP_MAX_ATTEMPTS", 5)
+	viper.SetDefault("PASSWORD_MIN_LENGTH", 8)
+	viper.SetDefault("PASSWORD_MAX_LENGTH", 64)
+	viper.SetDefault("PASSWORD_REQUIRE_UPPER", true)
+	viper.SetDefault("PASSWORD_REQUIRE_LOWER", true)
+	viper.SetDefault("PASSWORD_REQUIRE_DIGIT", true)
+	viper.SetDefault("PASSWORD_REQUIRE_SYMBOL", false)
+	viper.SetDefault("PASSWORD_DISALLOW_USER_INFO", true)
+	viper.SetDefault("PASSWORD_BREACHED_LIST_PATH", "")
+	viper.SetDefault("PASSWORD_HISTORY_SIZE", 5)
+	viper.SetDefault("THROTTLE_STORE", "memory")
+	viper.SetDefault("THROTTLE_FREE_ATTEMPTS", 3)
+	viper.SetDefault("THROTTLE_BASE_DELAY", "1s")
+	viper.SetDefault("THROTTLE_MAX_DELAY", "1m")
+	viper.SetDefault("THROTTLE_IP_MAX_FAILURES", 50)
+	viper.SetDefault("LOCKOUT_MAX_FAILURES", 10)
+	viper.SetDefault("LOCKOUT_WINDOW", "15m")
+	viper.SetDefault("LOCKOUT_DURATION", "15m")
+	viper.SetDefault("PASSWORD_HASH_ALGORITHM", "bcrypt")
+	viper.SetDefault("BCRYPT_COST", 14)
+	// KiB
+	viper.SetDefault("ARGON2_MEMORY", 64*1024)
+	viper.SetDefault("ARGON2_ITERATIONS", 3)
+	viper.SetDefault("ARGON2_PARALLELISM", 2)
+	// One of email, phone, any or all. Empty lets unverified accounts sign in.
+	viper.SetDefault("LOGIN_REQUIRE_VERIFIED", "")
+	// Comma separated client ids tokens must be issued to. A provider is
+	// disabled until its ids are set. The JWKS urls accept file:// paths.
+	viper.SetDefault("GOOGLE_CLIENT_IDS", "")
+	viper.SetDefault("GOOGLE_JWKS_URL", "https://www.googleapis.com/oauth2/v3/certs")
+	viper.SetDefault("APPLE_CLIENT_IDS", "")
+	viper.SetDefault("APPLE_JWKS_URL", "https://appleid.apple.com/auth/keys")
+	viper.SetDefault("FACEBOOK_APP_IDS", "")
+	viper.SetDefault("FACEBOOK_JWKS_URL", "https://www.facebook.com/.well-known/oauth/openid/jwks/")
+	viper.SetDefault("JWKS_CACHE_TTL", "1h")
+	// Page that receives the ?token= of a sign in link. Links are disabled when empty.
+	viper.SetDefault("PASSWORDLESS_LINK_URL", "")
+	// Sign in page /oauth/authorize sends users to with the request's query
+	// string. OpenID Connect sign ins are disabled when empty.
+	viper.SetDefault("OIDC_LOGIN_URL", "")
+	viper.SetDefault("AUTHORIZATION_CODE_TTL", "1m")
+	// The passkey relying party id and origins default to APP_URL
+	viper.SetDefault("WEBAUTHN_RP_ID", "")
+	viper.SetDefault("WEBAUTHN_ORIGINS", "")
+	viper.SetDefault("WEBAUTHN_TIMEOUT", "5m")
+	// Permission catalogue and built in roles. Empty uses pkg/rbac/default.yaml.
+	viper.SetDefault("RBAC_CONFIG_PATH", "")
+	// Embed roles, permissions and the permissions version in access tokens
+	viper.SetDefault("TOKEN_PERMISSION_CLAIMS", false)
+	// What ValidateToken does with claims older than the user's permissions:
+	// reject or flag
+	viper.SetDefault("STALE_PERMISSION_CLAIMS", "reject")
+}
@@ -0,0 +1,544 @@
+package helpers
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"log"
+	"math"
+	"math/big"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/google/uuid"
+	"github.com/lerryjay/auth-grpc-service/pkg/config"
+)
+
+// JSONWebKey is the public half of a signing key as described in RFC 7517
+type JSONWebKey struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+	Y   string `json:"y,omitempty"`
+}
+
+// JSONWebKeySet is the document served on the JWKS endpoint
+type JSONWebKeySet struct {
+	Keys []JSONWebKey `json:"keys"`
+}
+
+type jwtKey struct {
+	id        string
+	method    jwt.SigningMethod
+	private   crypto.Signer
+	public    crypto.PublicKey
+	publicJWK JSONWebKey
+}
+
+// JWTManager signs and verifies the access tokens issued by the service.
+// Only the current signing key is used to issue tokens, but every loaded
+// key is accepted for verification and published in the JWKS so keys can
+// be rotated without invalidating tokens already in circulation.
+type JWTManager struct {
+	signing   *jwtKey
+	keys      map[string]*jwtKey
+	issuer    string
+	audience  string
+	leeway    time.Duration
+	ttl       time.Duration
+	mfaTTL    time.Duration
+	clientTTL time.Duration
+}
+
+// NewJWTManager loads the signing key and any additional verification keys
+// from the paths in the config
+func NewJWTManager(cfg config.Config) (*JWTManager, error) {
+	m := &JWTManager{
+		keys:      map[string]*jwtKey{},
+		issuer:    cfg.APP_URL,
+		audience:  cfg.APP_NAME,
+		leeway:    cfg.JWT_CLOCK_SKEW,
+		ttl:       cfg.ACCESS_TOKEN_TTL,
+		mfaTTL:    cfg.MFA_TOKEN_TTL,
+		clientTTL: cfg.CLIENT_TOKEN_TTL,
+	}
+	// Tokens are bound to this service by their issuer and audience
+	if m.issuer == "" {
+		return nil, errors.New("APP_URL is not set")
+	}
+	if m.audience == "" {
+		return nil, errors.New("APP_NAME is not set")
+	}
+	if m.ttl == 0 {
+		m.ttl = time.Minute * 15
+	}
+	if m.mfaTTL == 0 {
+		m.mfaTTL = time.Minute * 5
+	}
+	if m.clientTTL == 0 {
+		m.clientTTL = time.Hour
+	}
+
+	var signer crypto.Signer
+	if cfg.JWT_PRIVATE_KEY_PATH == "" {
+		// Fine for local development, but tokens will not survive a restart
+		// and will not verify across replicas, so it has to be asked for.
+		if !cfg.JWT_EPHEMERAL_KEY {
+			return nil, errors.New("JWT_PRIVATE_KEY_PATH is not set")
+		}
+		log.Println("JWT_PRIVATE_KEY_PATH not set, generating an ephemeral signing key")
+		key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		if err != nil {
+			return nil, err
+		}
+		signer = key
+	} else {
+		key, err := readPrivateKey(cfg.JWT_PRIVATE_KEY_PATH)
+		if err != nil {
+			return nil, err
+		}
+		signer = key
+	}
+
+	signing, err := newJWTKey(cfg.JWT_KEY_ID, signer.Public())
+	if err != nil {
+		return nil, err
+	}
+	signing.private = signer
+	m.signing = signing
+	m.keys[signing.id] = signing
+
+	for _, path := range strings.Split(cfg.JWT_PUBLIC_KEYS_PATH, ",") {
+		path = strings.TrimSpace(path)
+		if path == "" {
+			continue
+		}
+		public, err := readPublicKey(path)
+		if err != nil {
+			return nil, err
+		}
+		key, err := newJWTKey("", public)
+		if err != nil {
+			return nil, err
+		}
+		m.keys[key.id] = key
+	}
+
+	return m, nil
+}
+
+// Values of the token_use claim. Tokens are only accepted for the use they
+// were issued for, so an MFA challenge can never be replayed as an access token.
+const (
+	TokenUseAccess    = "access"
+	TokenUseMFA       = "mfa"
+	TokenUseMagicLink = "magic_link"
+	TokenUseClient    = "client"
+	// Access tokens issued to OpenID Connect clients, only accepted by the
+	// userinfo endpoint
+	TokenUseOIDC = "oidc"
+	TokenUseID   = "id"
+)
+
+// TokenSubject describes who an access token is issued to
+type TokenSubject struct {
+	UserId    string
+	Role      string
+	SessionId string
+	// Optional permission claims
+	Authorization *TokenAuthorization
+}
+
+// TokenAuthorization is what the user was allowed to do when the token was
+// issued. Version is compared with the user's current permissions version
+// to tell whether the claims are still accurate.
+type TokenAuthorization struct {
+	Roles       []string
+	Permissions []string
+	Version     int64
+}
+
+// GenerateToken issues a signed access token for the subject
+func (m *JWTManager) GenerateToken(subject TokenSubject) (string, error) {
+	jsonStr, err := json.Marshal(map[string]string{
+		"Id":   subject.UserId,
+		"Role": subject.Role,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	claims := m.newClaims(subject.UserId, TokenUseAccess, m.ttl)
+	claims["user"] = string(jsonStr)
+	if subject.SessionId != "" {
+		claims["sid"] = subject.SessionId
+	}
+	if a := subject.Authorization; a != nil {
+		claims["roles"] = a.Roles
+		claims["permissions"] = a.Permissions
+		claims["pv"] = a.Version
+	}
+
+	return m.sign(claims)
+}
+
+// GenerateMFAToken issues the short lived challenge a user exchanges, along
+// with a second factor, for an access token
+func (m *JWTManager) GenerateMFAToken(userID string) (string, error) {
+	return m.sign(m.newClaims(userID, TokenUseMFA, m.mfaTTL))
+}
+
+// GenerateMagicLinkToken issues the token carried by a passwordless login
+// link. The nonce becomes the jti, which the caller stores to make the link
+// single use.
+func (m *JWTManager) GenerateMagicLinkToken(userID, nonce string, ttl time.Duration) (string, error) {
+	claims := m.newClaims(userID, TokenUseMagicLink, ttl)
+	claims["jti"] = nonce
+	return m.sign(claims)
+}
+
+// GenerateClientToken issues a service token to an OAuth2 client. The
+// scopes are the permissions the client may use.
+func (m *JWTManager) GenerateClientToken(clientID string, scopes []string) (string, error) {
+	claims := m.newClaims(clientID, TokenUseClient, m.clientTTL)
+	claims["client_id"] = clientID
+	claims["scope"] = strings.Join(scopes, " ")
+	return m.sign(claims)
+}
+
+// GenerateOIDCAccessToken issues the access token an OpenID Connect client
+// reads the user's profile from the userinfo endpoint with
+func (m *JWTManager) GenerateOIDCAccessToken(userID, clientID string, scopes []string) (string, error) {
+	claims := m.newClaims(userID, TokenUseOIDC, m.ttl)
+	claims["client_id"] = clientID
+	claims["scope"] = strings.Join(scopes, " ")
+	return m.sign(claims)
+}
+
+// GenerateIDToken issues an OpenID Connect ID token for the client. The
+// profile claims are added alongside the registered ones, which take
+// precedence.
+func (m *JWTManager) GenerateIDToken(userID, clientID string, profile map[string]interface{}) (string, error) {
+	claims := jwt.MapClaims{}
+	for name, value := range profile {
+		claims[name] = value
+	}
+	for name, value := range m.newClaims(userID, TokenUseID, m.ttl) {
+		claims[name] = value
+	}
+	claims["aud"] = clientID
+	claims["azp"] = clientID
+	return m.sign(claims)
+}
+
+func (m *JWTManager) newClaims(subject, use string, ttl time.Duration) jwt.MapClaims {
+	now := time.Now()
+	return jwt.MapClaims{
+		"aud":       m.audience,
+		"iss":       m.issuer,
+		"sub":       subject,
+		"iat":       now.Unix(),
+		"nbf":       now.Unix(),
+		"exp":       now.Add(ttl).Unix(),
+		"jti":       uuid.New().String(),
+		"token_use": use,
+	}
+}
+
+func (m *JWTManager) sign(claims jwt.MapClaims) (string, error) {
+	token := jwt.NewWithClaims(m.signing.method, claims)
+	token.Header["kid"] = m.signing.id
+
+	return token.SignedString(m.signing.private)
+}
+
+// AccessTokenTTL is the lifetime of the access tokens issued by GenerateToken
+func (m *JWTManager) AccessTokenTTL() time.Duration {
+	return m.ttl
+}
+
+// Issuer is the iss claim of the tokens we issue
+func (m *JWTManager) Issuer() string {
+	return m.issuer
+}
+
+// SigningAlgorithm is the JWS algorithm tokens are signed with
+func (m *JWTManager) SigningAlgorithm() string {
+	return m.signing.method.Alg()
+}
+
+// ClientTokenTTL is the lifetime of the tokens issued by GenerateClientToken
+func (m *JWTManager) ClientTokenTTL() time.Duration {
+	return m.clientTTL
+}
+
+// TokenClaims are the claims carried by the tokens we issue
+type TokenClaims struct {
+	jwt.RegisteredClaims
+	User      string `json:"user"`
+	SessionId string `json:"sid,omitempty"`
+	TokenUse  string `json:"token_use,omitempty"`
+	// Set when the token was issued with permission claims
+	Roles              []string `json:"roles,omitempty"`
+	Permissions        []string `json:"permissions,omitempty"`
+	PermissionsVersion *int64   `json:"pv,omitempty"`
+	// Set on tokens issued to OAuth2 clients
+	ClientId string `json:"client_id,omitempty"`
+	Scope    string `json:"scope,omitempty"`
+}
+
+// VerifyToken checks the signature and the registered claims of an access
+// token. Failures are reported as ErrTokenExpired, ErrTokenSignature,
+// ErrTokenMalformed or ErrTokenClaims.
+func (m *JWTManager) VerifyToken(tokenStr string) (*TokenClaims, error) {
+	return m.verify(tokenStr, TokenUseAccess)
+}
+
+// VerifyMFAToken checks a challenge issued by GenerateMFAToken
+func (m *JWTManager) VerifyMFAToken(tokenStr string) (*TokenClaims, error) {
+	return m.verify(tokenStr, TokenUseMFA)
+}
+
+// VerifyClientToken checks a token issued by GenerateClientToken
+func (m *JWTManager) VerifyClientToken(tokenStr string) (*TokenClaims, error) {
+	return m.verify(tokenStr, TokenUseClient)
+}
+
+// VerifyOIDCAccessToken checks a token issued by GenerateOIDCAccessToken
+func (m *JWTManager) VerifyOIDCAccessToken(tokenStr string) (*TokenClaims, error) {
+	return m.verify(tokenStr, TokenUseOIDC)
+}
+
+// VerifyMagicLinkToken checks a token issued by GenerateMagicLinkToken
+func (m *JWTManager) VerifyMagicLinkToken(tokenStr string) (*TokenClaims, error) {
+	return m.verify(tokenStr, TokenUseMagicLink)
+}
+
+// VerifyBearerToken checks any of the access tokens we issue: those issued
+// to users, OAuth2 clients and OpenID Connect clients. TokenUse tells them
+// apart.
+func (m *JWTManager) VerifyBearerToken(tokenStr string) (*TokenClaims, error) {
+	return m.verify(tokenStr, TokenUseAccess, TokenUseClient, TokenUseOIDC)
+}
+
+func (m *JWTManager) verify(tokenStr string, uses ...string) (*TokenClaims, error) {
+	options := []jwt.ParserOption{
+		jwt.WithValidMethods(m.methods()),
+		jwt.WithLeeway(m.leeway),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithAudience(m.audience),
+		jwt.WithIssuer(m.issuer),
+	}
+
+	claims := &TokenClaims{}
+	_, err := jwt.ParseWithClaims(tokenStr, claims, m.keyFunc, options...)
+	if err != nil {
+		return nil, classifyTokenError(err)
+	}
+
+	// Tokens without a token_use match none of the uses, so they're rejected
+	for _, use := range uses {
+		if claims.TokenUse == use {
+			return claims, nil
+		}
+	}
+	return nil, ErrTokenClaims
+}
+
+// ValidateJWTToken verifies the token and returns the user claim
+func (m *JWTManager) ValidateJWTToken(tokenStr string) (string, error) {
+	claims, err := m.VerifyToken(tokenStr)
+	if err != nil {
+		return "", err
+	}
+	return claims.User, nil
+}
+
+// JWKS returns the public keys tokens can be verified with
+func (m *JWTManager) JWKS() JSONWebKeySet {
+	set := JSONWebKeySet{Keys: []JSONWebKey{m.signing.publicJWK}}
+	for id, key := range m.keys {
+		if id != m.signing.id {
+			set.Keys = append(set.Keys, key.publicJWK)
+		}
+	}
+	return set
+}
+
+func (m *JWTManager) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	key, ok := m.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	if token.Method.Alg() != key.method.Alg() {
+		return nil, fmt.Errorf("algorithm %s does not match key %q", token.Method.Alg(), kid)
+	}
+	return key.public, nil
+}
+
+func (m *JWTManager) methods() []string {
+	seen := map[string]bool{}
+	var methods []string
+	for _, key := range m.keys {
+		if alg := key.method.Alg(); !seen[alg] {
+			seen[alg] = true
+			methods = append(methods, alg)
+		}
+	}
+	return methods
+}
+
+func newJWTKey(id string, public crypto.PublicKey) (*jwtKey, error) {
+	key := &jwtKey{public: public}
+
+	switch pub := public.(type) {
+	case *rsa.PublicKey:
+		key.method = jwt.SigningMethodRS256
+		key.publicJWK = JSONWebKey{
+			Kty: "RSA",
+			Alg: "RS256",
+			N:   base64.RawURLEncoding.EncodeToString(pub.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes()),
+		}
+	case *ecdsa.PublicKey:
+		if pub.Curve != elliptic.P256() {
+			return nil, errors.New("only P-256 EC keys are supported")
+		}
+		key.method = jwt.SigningMethodES256
+		key.publicJWK = JSONWebKey{
+			Kty: "EC",
+			Alg: "ES256",
+			Crv: "P-256",
+			X:   base64.RawURLEncoding.EncodeToString(pub.X.FillBytes(make([]byte, 32))),
+			Y:   base64.RawURLEncoding.EncodeToString(pub.Y.FillBytes(make([]byte, 32))),
+		}
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", public)
+	}
+
+	if id == "" {
+		id = jwkThumbprint(key.publicJWK)
+	}
+	key.id = id
+	key.publicJWK.Kid = id
+	key.publicJWK.Use = "sig"
+
+	return key, nil
+}
+
+// ParseJWK returns the public key described by a JSON Web Key. Only the RSA
+// and P-256 EC keys used for RS256 and ES256 are supported.
+func ParseJWK(k JSONWebKey) (crypto.PublicKey, error) {
+	switch k.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(k.N)
+		if err != nil {
+			return nil, err
+		}
+		e, err := base64.RawURLEncoding.DecodeString(k.E)
+		if err != nil {
+			return nil, err
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if !exponent.IsInt64() || exponent.Int64() > math.MaxInt32 {
+			return nil, errors.New("RSA exponent is too large")
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	case "EC":
+		if k.Crv != "P-256" {
+			return nil, fmt.Errorf("unsupported curve %q", k.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(k.X)
+		if err != nil {
+			return nil, err
+		}
+		y, err := base64.RawURLEncoding.DecodeString(k.Y)
+		if err != nil {
+			return nil, err
+		}
+		key := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !key.Curve.IsOnCurve(key.X, key.Y) {
+			return nil, errors.New("EC point is not on the curve")
+		}
+		return key, nil
+	}
+	return nil, fmt.Errorf("unsupported key type %q", k.Kty)
+}
+
+// jwkThumbprint computes the RFC 7638 thumbprint used as the default key id
+func jwkThumbprint(k JSONWebKey) string {
+	var members string
+	if k.Kty == "RSA" {
+		members = fmt.Sprintf(`{"e":"%s","kty":"RSA","n":"%s"}`, k.E, k.N)
+	} else {
+		members = fmt.Sprintf(`{"crv":"%s","kty":"EC","x":"%s","y":"%s"}`, k.Crv, k.X, k.Y)
+	}
+	sum := sha256.Sum256([]byte(members))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+func readPEM(path string) (*pem.Block, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found in %s", path)
+	}
+	return block, nil
+}
+
+func readPrivateKey(path string) (crypto.Signer, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("unsupported private key in %s", path)
+	}
+	return signer, nil
+}
+
+func readPublicKey(path string) (crypto.PublicKey, error) {
+	block, err := readPEM(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if block.Type == "RSA PUBLIC KEY" {
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
@@ -0,0 +1,69 @@
+package helpers
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/config"
+)
+
+func TestVerifyTokenUse(t *testing.T) {
+	m, err := NewJWTManager(config.Config{
+		APP_NAME:          "example",
+		APP_URL:           "https://example.com",
+		JWT_EPHEMERAL_KEY: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	verifiers := map[string]func(string) (*TokenClaims, error){
+		"access":     m.VerifyToken,
+		"mfa":        m.VerifyMFAToken,
+		"client":     m.VerifyClientToken,
+		"oidc":       m.VerifyOIDCAccessToken,
+		"magic link": m.VerifyMagicLinkToken,
+		"bearer":     m.VerifyBearerToken,
+	}
+
+	tests := []struct {
+		use string
+		// Verifiers that accept a token issued for the use
+		accepted []string
+	}{
+		{TokenUseAccess, []string{"access", "bearer"}},
+		{TokenUseMFA, []string{"mfa"}},
+		{TokenUseClient, []string{"client", "bearer"}},
+		{TokenUseOIDC, []string{"oidc", "bearer"}},
+		{TokenUseMagicLink, []string{"magic link"}},
+		{TokenUseID, nil},
+		{"", nil},
+	}
+
+	for _, tt := range tests {
+		claims := m.newClaims("user-1", tt.use, time.Minute)
+		if tt.use == "" {
+			delete(claims, "token_use")
+		}
+		token, err := m.sign(claims)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for name, verify := range verifiers {
+			want := false
+			for _, accepted := range tt.accepted {
+				want = want || accepted == name
+			}
+
+			_, err := verify(token)
+			if want && err != nil {
+				t.Errorf("%q token: %s verifier returned %v", tt.use, name, err)
+			}
+			if !want && !errors.Is(err, ErrTokenClaims) {
+				t.Errorf("%q token: %s verifier returned %v, want %v", tt.use, name, err, ErrTokenClaims)
+			}
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package helpers
+
+import (
+	"errors"
+
+	"github.com/golang-jwt/jwt/v5"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Reasons attached to Unauthenticated errors so clients can tell whether to
+// refresh the token or send the user back to login
+const (
+	TokenExpiredReason   = "TOKEN_EXPIRED"
+	TokenSignatureReason = "TOKEN_SIGNATURE_INVALID"
+	TokenMalformedReason = "TOKEN_MALFORMED"
+	TokenClaimsReason    = "TOKEN_CLAIMS_INVALID"
+	SessionRevokedReason = "SESSION_REVOKED"
+	// The token's permission claims are out of date; refresh it
+	PermissionsStaleReason = "PERMISSIONS_STALE"
+	ClientDisabledReason   = "CLIENT_DISABLED"
+	TokenRevokedReason     = "TOKEN_REVOKED"
+)
+
+var (
+	ErrTokenExpired   = errors.New("token has expired")
+	ErrTokenSignature = errors.New("token signature is invalid")
+	ErrTokenMalformed = errors.New("token is malformed")
+	ErrTokenClaims    = errors.New("token claims are invalid")
+	ErrSessionRevoked = errors.New("session has been revoked")
+	// ErrPermissionsStale means the user's permissions changed after the
+	// token was issued
+	ErrPermissionsStale = errors.New("token permissions are out of date")
+	// ErrClientDisabled means the OAuth2 client the token was issued to has
+	// been disabled
+	ErrClientDisabled = errors.New("client has been disabled")
+	ErrTokenRevoked   = errors.New("token has been revoked")
+)
+
+func classifyTokenError(err error) error {
+	switch {
+	case errors.Is(err, jwt.ErrTokenMalformed):
+		return ErrTokenMalformed
+	case errors.Is(err, jwt.ErrTokenSignatureInvalid), errors.Is(err, jwt.ErrTokenUnverifiable):
+		return ErrTokenSignature
+	case errors.Is(err, jwt.ErrTokenExpired):
+		return ErrTokenExpired
+	default:
+		return ErrTokenClaims
+	}
+}
+
+// TokenStatusError converts a token verification error into an
+// Unauthenticated status carrying an ErrorInfo detail with the reason
+func TokenStatusError(err error) error {
+	reason, message := TokenClaimsReason, "Invalid authentication token"
+	switch {
+	case errors.Is(err, ErrTokenExpired):
+		reason, message = TokenExpiredReason, "Authentication token has expired"
+	case errors.Is(err, ErrTokenSignature):
+		reason, message = TokenSignatureReason, "Authentication token signature is invalid"
+	case errors.Is(err, ErrTokenMalformed):
+		reason, message = TokenMalformedReason, "Authentication token is malformed"
+	case errors.Is(err, ErrSessionRevoked):
+		reason, message = SessionRevokedReason, "Session has been revoked"
+	case errors.Is(err, ErrPermissionsStale):
+		reason, message = PermissionsStaleReason, "Authentication token permissions are out of date"
+	case errors.Is(err, ErrClientDisabled):
+		reason, message = ClientDisabledReason, "Client has been disabled"
+	case errors.Is(err, ErrTokenRevoked):
+		reason, message = TokenRevokedReason, "Authentication token has been revoked"
+	}
+
+	st := status.New(codes.Unauthenticated, message)
+	detailed, detailErr := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: reason,
+		Domain: "auth",
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
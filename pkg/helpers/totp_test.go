@@ -0,0 +1,121 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+)
+
+// The SHA1 secret from RFC 6238 appendix B, "12345678901234567890"
+const rfc6238Secret = "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+func TestValidateTOTPVectors(t *testing.T) {
+	// RFC 6238 appendix B. The RFC's codes have 8 digits and ours have 6,
+	// which are the last 6 of the same value.
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1111111111, "050471"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+		{20000000000, "353130"},
+	}
+
+	for _, tt := range tests {
+		step, ok := ValidateTOTP(rfc6238Secret, tt.code, time.Unix(tt.unix, 0))
+		if !ok {
+			t.Errorf("%d: code %s was rejected", tt.unix, tt.code)
+			continue
+		}
+		if want := tt.unix / totpPeriod; step != want {
+			t.Errorf("%d: got step %d, want %d", tt.unix, step, want)
+		}
+	}
+}
+
+func TestValidateTOTPDrift(t *testing.T) {
+	// 081804 is the code for step 37037036
+	const code = "081804"
+	const step = 37037036
+	issued := time.Unix(step*totpPeriod, 0)
+
+	tests := []struct {
+		name   string
+		offset time.Duration
+		ok     bool
+	}{
+		{"same step", 0, true},
+		{"end of the step", 29 * time.Second, true},
+		{"one step early", -30 * time.Second, true},
+		{"one step late", 30 * time.Second, true},
+		{"two steps early", -31 * time.Second, false},
+		{"two steps late", 60 * time.Second, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := ValidateTOTP(rfc6238Secret, code, issued.Add(tt.offset))
+			if ok != tt.ok {
+				t.Fatalf("got %v, want %v", ok, tt.ok)
+			}
+			// The step the code was issued for, not the current one, so a
+			// drifting code can't be replayed in the next step
+			if ok && got != step {
+				t.Errorf("got step %d, want %d", got, step)
+			}
+		})
+	}
+}
+
+func TestValidateTOTPRejects(t *testing.T) {
+	now := time.Unix(1111111109, 0)
+	tests := []struct {
+		name   string
+		secret string
+		code   string
+	}{
+		{"wrong code", rfc6238Secret, "081805"},
+		{"too short", rfc6238Secret, "81804"},
+		{"too long", rfc6238Secret, "0081804"},
+		{"empty", rfc6238Secret, ""},
+		{"invalid secret", "not base32!", "081804"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := ValidateTOTP(tt.secret, tt.code, now); ok {
+				t.Error("code was accepted")
+			}
+		})
+	}
+}
+
+func TestGenerateTOTPSecret(t *testing.T) {
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := totpEncoding.DecodeString(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(key) != 20 {
+		t.Errorf("got a %d byte secret, want 20", len(key))
+	}
+
+	other, err := GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == secret {
+		t.Error("two secrets were the same")
+	}
+
+	now := time.Now()
+	code := hotp(key, uint64(now.Unix()/totpPeriod))
+	if _, ok := ValidateTOTP(secret, code, now); !ok {
+		t.Errorf("code %s for a generated secret was rejected", code)
+	}
+}
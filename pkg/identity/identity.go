@@ -0,0 +1,191 @@
+// Package identity verifies the ID tokens third party identity providers
+// issue, so a social login is only trusted once the provider has vouched
+// for who the user is.
+package identity
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lerryjay/auth-grpc-service/pkg/config"
+)
+
+var (
+	// ErrUnknownProvider is returned for issuers no provider is configured for
+	ErrUnknownProvider = errors.New("identity provider is not configured")
+	// ErrInvalidToken is returned when the token fails verification
+	ErrInvalidToken = errors.New("identity token is invalid")
+	// ErrEmailNotVerified is returned when the provider hasn't verified the email
+	ErrEmailNotVerified = errors.New("email address is not verified by the provider")
+)
+
+// Claims are the verified details of the user a token was issued to
+type Claims struct {
+	// Name of the provider that verified the token
+	Provider string
+	// Issuer and Subject together identify the user at the provider
+	Issuer    string
+	Subject   string
+	Email     string
+	FirstName string
+	LastName  string
+	Picture   string
+}
+
+// Verifier checks an ID token issued by one provider
+type Verifier interface {
+	Verify(ctx context.Context, token string) (*Claims, error)
+}
+
+// Provider verifies ID tokens signed with the keys in its key set
+type Provider struct {
+	Name      string
+	Issuers   []string
+	Audiences []string
+	Keys      *KeySet
+	Leeway    time.Duration
+	// Some providers only share addresses they have verified and don't send
+	// an email_verified claim
+	AssumeEmailVerified bool
+}
+
+// idTokenClaims are the OpenID Connect claims we read
+type idTokenClaims struct {
+	jwt.RegisteredClaims
+	Email         string      `json:"email"`
+	EmailVerified interface{} `json:"email_verified"`
+	GivenName     string      `json:"given_name"`
+	FamilyName    string      `json:"family_name"`
+	Picture       interface{} `json:"picture"`
+}
+
+func (p *Provider) Verify(ctx context.Context, token string) (*Claims, error) {
+	claims := &idTokenClaims{}
+	_, err := jwt.ParseWithClaims(token, claims, func(t *jwt.Token) (interface{}, error) {
+		kid, _ := t.Header["kid"].(string)
+		return p.Keys.Key(ctx, kid)
+	},
+		jwt.WithValidMethods([]string{"RS256", "ES256"}),
+		jwt.WithLeeway(p.Leeway),
+		jwt.WithExpirationRequired(),
+		jwt.WithIssuedAt(),
+		jwt.WithAudience(p.Audiences...),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+
+	if !contains(p.Issuers, claims.Issuer) {
+		return nil, fmt.Errorf("%w: unexpected issuer %q", ErrInvalidToken, claims.Issuer)
+	}
+	if claims.Subject == "" || claims.Email == "" {
+		return nil, fmt.Errorf("%w: subject and email are required", ErrInvalidToken)
+	}
+	if !p.AssumeEmailVerified && !isTrue(claims.EmailVerified) {
+		return nil, ErrEmailNotVerified
+	}
+
+	verified := &Claims{
+		Provider:  p.Name,
+		Issuer:    claims.Issuer,
+		Subject:   claims.Subject,
+		Email:     strings.ToLower(claims.Email),
+		FirstName: claims.GivenName,
+		LastName:  claims.FamilyName,
+	}
+	// Google sends the picture url as a string, Facebook as an object
+	if picture, ok := claims.Picture.(string); ok {
+		verified.Picture = picture
+	}
+
+	return verified, nil
+}
+
+// isTrue accepts the boolean and the "true" string forms of a claim, since
+// Apple sends email_verified as a string
+func isTrue(v interface{}) bool {
+	switch b := v.(type) {
+	case bool:
+		return b
+	case string:
+		return b == "true"
+	}
+	return false
+}
+
+func contains(values []string, v string) bool {
+	for _, value := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+// Registry picks the provider for a login request
+type Registry struct {
+	providers []*Provider
+}
+
+// NewRegistry creates the providers that have client ids in the config
+func NewRegistry(cfg config.Config) *Registry {
+	r := &Registry{}
+
+	add := func(name, clientIDs, jwksURL string, issuers []string, assumeVerified bool) {
+		audiences := splitList(clientIDs)
+		if len(audiences) == 0 {
+			return
+		}
+		r.providers = append(r.providers, &Provider{
+			Name:                name,
+			Issuers:             issuers,
+			Audiences:           audiences,
+			Keys:                NewKeySet(jwksURL, cfg.JWKS_CACHE_TTL),
+			Leeway:              cfg.JWT_CLOCK_SKEW,
+			AssumeEmailVerified: assumeVerified,
+		})
+	}
+
+	add("google", cfg.GOOGLE_CLIENT_IDS, cfg.GOOGLE_JWKS_URL,
+		[]string{"https://accounts.google.com", "accounts.google.com"}, false)
+	add("apple", cfg.APPLE_CLIENT_IDS, cfg.APPLE_JWKS_URL,
+		[]string{"https://appleid.apple.com"}, false)
+	add("facebook", cfg.FACEBOOK_APP_IDS, cfg.FACEBOOK_JWKS_URL,
+		[]string{"https://www.facebook.com"}, true)
+
+	return r
+}
+
+// Provider returns the provider for the issuer, which may be given either as
+// the provider name, e.g. "google", or as the issuer url in its tokens
+func (r *Registry) Provider(issuer string) (*Provider, error) {
+	for _, p := range r.providers {
+		if strings.EqualFold(p.Name, issuer) || contains(p.Issuers, issuer) {
+			return p, nil
+		}
+	}
+	return nil, ErrUnknownProvider
+}
+
+// Verify checks the token with the provider for the issuer
+func (r *Registry) Verify(ctx context.Context, issuer, token string) (*Claims, error) {
+	p, err := r.Provider(issuer)
+	if err != nil {
+		return nil, err
+	}
+	return p.Verify(ctx, token)
+}
+
+func splitList(s string) []string {
+	var values []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
@@ -0,0 +1,174 @@
+package identity
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/lerryjay/auth-grpc-service/pkg/config"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+)
+
+const testKid = "test-key"
+
+func generateKey(t *testing.T) *ecdsa.PrivateKey {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key
+}
+
+// writeJWKS publishes the key in a JWKS file and returns its file:// url
+func writeJWKS(t *testing.T, key *ecdsa.PrivateKey) string {
+	t.Helper()
+	set := helpers.JSONWebKeySet{Keys: []helpers.JSONWebKey{{
+		Kty: "EC",
+		Kid: testKid,
+		Use: "sig",
+		Alg: "ES256",
+		Crv: "P-256",
+		X:   base64.RawURLEncoding.EncodeToString(key.X.FillBytes(make([]byte, 32))),
+		Y:   base64.RawURLEncoding.EncodeToString(key.Y.FillBytes(make([]byte, 32))),
+	}}}
+	data, err := json.Marshal(set)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return "file://" + path
+}
+
+func sign(t *testing.T, key *ecdsa.PrivateKey, kid string, claims jwt.MapClaims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(jwt.SigningMethodES256, claims)
+	token.Header["kid"] = kid
+	signed, err := token.SignedString(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed
+}
+
+func TestRegistryVerify(t *testing.T) {
+	key := generateKey(t)
+	jwks := writeJWKS(t, key)
+	registry := NewRegistry(config.Config{
+		GOOGLE_CLIENT_IDS: "google-client",
+		GOOGLE_JWKS_URL:   jwks,
+		APPLE_CLIENT_IDS:  "com.example.app",
+		APPLE_JWKS_URL:    jwks,
+		FACEBOOK_APP_IDS:  "facebook-app",
+		FACEBOOK_JWKS_URL: jwks,
+		JWKS_CACHE_TTL:    time.Hour,
+	})
+
+	claims := func(issuer, audience string, changes jwt.MapClaims) jwt.MapClaims {
+		now := time.Now()
+		c := jwt.MapClaims{
+			"iss":            issuer,
+			"aud":            audience,
+			"sub":            "provider-user",
+			"email":          "Ada@Example.com",
+			"email_verified": true,
+			"iat":            now.Unix(),
+			"exp":            now.Add(time.Hour).Unix(),
+		}
+		for k, v := range changes {
+			if v == nil {
+				delete(c, k)
+			} else {
+				c[k] = v
+			}
+		}
+		return c
+	}
+	google := func(changes jwt.MapClaims) jwt.MapClaims {
+		return claims("https://accounts.google.com", "google-client", changes)
+	}
+	apple := func(changes jwt.MapClaims) jwt.MapClaims {
+		return claims("https://appleid.apple.com", "com.example.app", changes)
+	}
+
+	tests := []struct {
+		name     string
+		provider string
+		key      *ecdsa.PrivateKey
+		kid      string
+		claims   jwt.MapClaims
+		want     error
+	}{
+		{"valid token", "google", key, testKid, google(nil), nil},
+		{"provider named by issuer", "https://accounts.google.com", key, testKid, google(nil), nil},
+		{"bad signature", "google", generateKey(t), testKid, google(nil), ErrInvalidToken},
+		{"unknown key id", "google", key, "other-key", google(nil), ErrInvalidToken},
+		{"wrong audience", "google", key, testKid, google(jwt.MapClaims{"aud": "someone-else"}), ErrInvalidToken},
+		{"wrong issuer", "google", key, testKid, google(jwt.MapClaims{"iss": "https://appleid.apple.com"}), ErrInvalidToken},
+		{"expired", "google", key, testKid, google(jwt.MapClaims{"exp": time.Now().Add(-time.Minute).Unix()}), ErrInvalidToken},
+		{"no expiry", "google", key, testKid, google(jwt.MapClaims{"exp": nil}), ErrInvalidToken},
+		{"issued in the future", "google", key, testKid, google(jwt.MapClaims{"iat": time.Now().Add(time.Hour).Unix()}), ErrInvalidToken},
+		{"no subject", "google", key, testKid, google(jwt.MapClaims{"sub": nil}), ErrInvalidToken},
+		{"email not verified", "google", key, testKid, google(jwt.MapClaims{"email_verified": false}), ErrEmailNotVerified},
+		{"email verification missing", "google", key, testKid, google(jwt.MapClaims{"email_verified": nil}), ErrEmailNotVerified},
+		{"apple string true", "apple", key, testKid, apple(jwt.MapClaims{"email_verified": "true"}), nil},
+		{"apple string false", "apple", key, testKid, apple(jwt.MapClaims{"email_verified": "false"}), ErrEmailNotVerified},
+		{"facebook without email_verified", "facebook", key, testKid,
+			claims("https://www.facebook.com", "facebook-app", jwt.MapClaims{"email_verified": nil}), nil},
+		{"unknown provider", "github", key, testKid, google(nil), ErrUnknownProvider},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			token := sign(t, tt.key, tt.kid, tt.claims)
+			verified, err := registry.Verify(context.Background(), tt.provider, token)
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("got %v, want %v", err, tt.want)
+			}
+			if err != nil {
+				return
+			}
+			if verified.Subject != "provider-user" || verified.Email != "ada@example.com" {
+				t.Errorf("got subject %q and email %q", verified.Subject, verified.Email)
+			}
+		})
+	}
+}
+
+func TestVerifyRejectsUnsignedTokens(t *testing.T) {
+	registry := NewRegistry(config.Config{
+		GOOGLE_CLIENT_IDS: "google-client",
+		GOOGLE_JWKS_URL:   writeJWKS(t, generateKey(t)),
+		JWKS_CACHE_TTL:    time.Hour,
+	})
+
+	token := jwt.NewWithClaims(jwt.SigningMethodNone, jwt.MapClaims{
+		"iss":            "https://accounts.google.com",
+		"aud":            "google-client",
+		"sub":            "provider-user",
+		"email":          "ada@example.com",
+		"email_verified": true,
+		"exp":            time.Now().Add(time.Hour).Unix(),
+	})
+	token.Header["kid"] = testKid
+	unsigned, err := token.SignedString(jwt.UnsafeAllowNoneSignatureType)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := registry.Verify(context.Background(), "google", unsigned); !errors.Is(err, ErrInvalidToken) {
+		t.Fatalf("got %v, want %v", err, ErrInvalidToken)
+	}
+}
@@ -0,0 +1,114 @@
+package identity
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+)
+
+// How often an unknown key id may trigger a refetch before the cache expires,
+// so tokens with made up key ids can't be used to hammer the provider
+const minRefreshInterval = time.Minute
+
+// KeySet caches the signing keys a provider publishes on its JWKS endpoint.
+// A source starting with file:// is read from disk instead, which lets tests
+// sign tokens with their own keys.
+type KeySet struct {
+	source string
+	ttl    time.Duration
+
+	mu      sync.Mutex
+	keys    map[string]crypto.PublicKey
+	fetched time.Time
+}
+
+// NewKeySet creates a key set for the JWKS at source. Keys are refetched
+// once they are older than ttl.
+func NewKeySet(source string, ttl time.Duration) *KeySet {
+	return &KeySet{source: source, ttl: ttl}
+}
+
+// Key returns the public key with the key id
+func (s *KeySet) Key(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	age := time.Since(s.fetched)
+	_, known := s.keys[kid]
+	if s.keys == nil || age > s.ttl || (!known && age > minRefreshInterval) {
+		if err := s.refresh(ctx); err != nil {
+			if s.keys == nil {
+				return nil, err
+			}
+			// Keep using the keys we have until the provider is back
+			log.Println("Error refreshing JWKS from", s.source, err)
+		}
+	}
+
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("unknown signing key %q", kid)
+	}
+	return key, nil
+}
+
+func (s *KeySet) refresh(ctx context.Context) error {
+	data, err := s.read(ctx)
+	if err != nil {
+		return err
+	}
+
+	var set helpers.JSONWebKeySet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return err
+	}
+
+	keys := map[string]crypto.PublicKey{}
+	for _, jwk := range set.Keys {
+		if jwk.Use != "" && jwk.Use != "sig" {
+			continue
+		}
+		key, err := helpers.ParseJWK(jwk)
+		if err != nil {
+			// Providers may publish key types we don't use
+			continue
+		}
+		keys[jwk.Kid] = key
+	}
+
+	s.keys = keys
+	s.fetched = time.Now()
+	return nil
+}
+
+func (s *KeySet) read(ctx context.Context) ([]byte, error) {
+	if path := strings.TrimPrefix(s.source, "file://"); path != s.source {
+		return os.ReadFile(path)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.source, nil)
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{Timeout: 10 * time.Second}
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching %s returned %s", s.source, resp.Status)
+	}
+	return io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+}
@@ -0,0 +1,136 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/config"
+)
+
+// Channel is the medium a notification is delivered over
+type Channel string
+
+const (
+	Email Channel = "email"
+	SMS   Channel = "sms"
+)
+
+// Message is a rendered notification ready for delivery
+type Message struct {
+	Channel Channel `json:"channel"`
+	To      string  `json:"to"`
+	Subject string  `json:"subject,omitempty"`
+	Body    string  `json:"body"`
+}
+
+// Notifier delivers messages for a single channel
+type Notifier interface {
+	Notify(ctx context.Context, msg Message) error
+}
+
+// ErrNoDestination is returned when a user has neither an email nor a phone number
+var ErrNoDestination = errors.New("no destination to notify")
+
+// Service renders templates and hands the result to the notifier for the channel
+type Service struct {
+	Email Notifier
+	SMS   Notifier
+}
+
+// New builds the notifiers from the config. Channels without a provider
+// configured are left out, and Send fails only when one of them is used.
+// With NOTIFY_DRIVER set to file they are written to NOTIFY_FILE_PATH
+// instead, so local setups still work.
+func New(cfg config.Config) (*Service, error) {
+	s := &Service{}
+	switch cfg.NOTIFY_DRIVER {
+	case "", "file":
+	default:
+		return nil, errors.New("unknown NOTIFY_DRIVER " + cfg.NOTIFY_DRIVER)
+	}
+	useFile := cfg.NOTIFY_DRIVER == "file"
+
+	if cfg.SMTP_HOST != "" {
+		s.Email = &SMTPNotifier{
+			Host:     cfg.SMTP_HOST,
+			Port:     cfg.SMTP_PORT,
+			Username: cfg.SMTP_USERNAME,
+			Password: cfg.SMTP_PASSWORD,
+			From:     cfg.SMTP_FROM,
+		}
+	} else if useFile {
+		log.Println("SMTP_HOST not set, writing emails to", cfg.NOTIFY_FILE_PATH)
+		s.Email = &FileNotifier{Path: cfg.NOTIFY_FILE_PATH}
+	} else {
+		log.Println("SMTP_HOST not set, emails cannot be sent")
+	}
+
+	if cfg.SMS_GATEWAY_URL != "" {
+		s.SMS = &SMSGatewayNotifier{
+			URL:    cfg.SMS_GATEWAY_URL,
+			APIKey: cfg.SMS_API_KEY,
+			Sender: cfg.SMS_SENDER,
+		}
+	} else if useFile {
+		log.Println("SMS_GATEWAY_URL not set, writing text messages to", cfg.NOTIFY_FILE_PATH)
+		s.SMS = &FileNotifier{Path: cfg.NOTIFY_FILE_PATH}
+	} else {
+		log.Println("SMS_GATEWAY_URL not set, text messages cannot be sent")
+	}
+
+	return s, nil
+}
+
+// Send renders the named template for the channel and delivers it
+func (s *Service) Send(ctx context.Context, channel Channel, to, template string, data interface{}) error {
+	subject, body, err := Render(channel, template, data)
+	if err != nil {
+		return err
+	}
+
+	notifier := s.Email
+	if channel == SMS {
+		notifier = s.SMS
+	}
+	if notifier == nil {
+		return errors.New("no notifier configured for " + string(channel))
+	}
+
+	return notifier.Notify(ctx, Message{
+		Channel: channel,
+		To:      to,
+		Subject: subject,
+		Body:    body,
+	})
+}
+
+// SendToUser delivers to the user's email when they have one and falls back
+// to their phone. It returns the masked destination the message went to.
+func (s *Service) SendToUser(ctx context.Context, email, telephone, template string, data interface{}) (string, error) {
+	if email != "" {
+		return MaskEmail(email), s.Send(ctx, Email, email, template, data)
+	}
+	if telephone != "" {
+		return MaskPhone(telephone), s.Send(ctx, SMS, telephone, template, data)
+	}
+	return "", ErrNoDestination
+}
+
+// MaskEmail hides all but the first character of the local part, e.g. j***@example.com
+func MaskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at < 1 {
+		return "***"
+	}
+	return email[:1] + "***" + email[at:]
+}
+
+// MaskPhone hides all but the last four digits
+func MaskPhone(phone string) string {
+	if len(phone) <= 4 {
+		return "***"
+	}
+	return strings.Repeat("*", len(phone)-4) + phone[len(phone)-4:]
+}
@@ -0,0 +1,38 @@
+package notify
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+)
+
+// SMSGatewayNotifier posts text messages to an HTTP SMS gateway as
+// {"to": ..., "from": ..., "message": ...} with the API key as a bearer token
+type SMSGatewayNotifier struct {
+	URL    string
+	APIKey string
+	Sender string
+}
+
+func (n *SMSGatewayNotifier) Notify(ctx context.Context, msg Message) error {
+	payload := map[string]string{
+		"to":      msg.To,
+		"from":    n.Sender,
+		"message": msg.Body,
+	}
+	headers := map[string]string{
+		"Authorization": fmt.Sprintf("Bearer %s", n.APIKey),
+	}
+
+	resp, err := helpers.PostRequest(n.URL, payload, headers)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("SMS gateway returned %s", resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+package notify
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"net/smtp"
+	"strings"
+)
+
+// SMTPNotifier sends plain text email through an SMTP relay
+type SMTPNotifier struct {
+	Host     string
+	Port     string
+	Username string
+	Password string
+	From     string
+}
+
+func (n *SMTPNotifier) Notify(ctx context.Context, msg Message) error {
+	to, body, err := n.message(msg)
+	if err != nil {
+		return err
+	}
+
+	var auth smtp.Auth
+	if n.Username != "" {
+		auth = smtp.PlainAuth("", n.Username, n.Password, n.Host)
+	}
+
+	addr := fmt.Sprintf("%s:%s", n.Host, n.Port)
+	return smtp.SendMail(addr, auth, n.From, []string{to}, body)
+}
+
+// message builds the email for msg, returning the address it goes to.
+// Recipients and subjects come from user input, so anything that could add
+// headers of its own is rejected.
+func (n *SMTPNotifier) message(msg Message) (string, []byte, error) {
+	to, err := mail.ParseAddress(msg.To)
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid recipient: %w", err)
+	}
+	if strings.ContainsAny(msg.Subject, "\r\n") {
+		return "", nil, errors.New("subject contains a line break")
+	}
+
+	headers := []string{
+		"From: " + n.From,
+		"To: " + to.String(),
+		"Subject: " + msg.Subject,
+		"MIME-Version: 1.0",
+		"Content-Type: text/plain; charset=UTF-8",
+	}
+	body := strings.Join(headers, "\r\n") + "\r\n\r\n" + msg.Body
+	return to.Address, []byte(body), nil
+}
@@ -0,0 +1,46 @@
+package notify
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestSMTPMessage(t *testing.T) {
+	n := &SMTPNotifier{From: "noreply@example.com"}
+
+	tests := []struct {
+		name    string
+		to      string
+		subject string
+		wantTo  string
+		wantErr bool
+	}{
+		{"address", "ada@example.com", "Reset your password", "ada@example.com", false},
+		{"address with a name", "Ada <ada@example.com>", "Reset your password", "ada@example.com", false},
+		{"header in the recipient", "ada@example.com\r\nBcc: eve@example.net", "Reset your password", "", true},
+		{"newline in the recipient", "ada@example.com\nBcc: eve@example.net", "Reset your password", "", true},
+		{"two recipients", "ada@example.com, eve@example.net", "Reset your password", "", true},
+		{"not an address", "ada", "Reset your password", "", true},
+		{"header in the subject", "ada@example.com", "Hi\r\nBcc: eve@example.net", "", true},
+		{"newline in the subject", "ada@example.com", "Hi\nBcc: eve@example.net", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			to, body, err := n.message(Message{Channel: Email, To: tt.to, Subject: tt.subject, Body: "Your code is 123456"})
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if to != tt.wantTo {
+				t.Errorf("got recipient %q, want %q", to, tt.wantTo)
+			}
+			headers, _, _ := strings.Cut(string(body), "\r\n\r\n")
+			if strings.Contains(headers, "Bcc") || strings.Count(headers, "\r\n") != 4 {
+				t.Errorf("got headers %q", headers)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package notify
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+//go:embed templates
+var templateFS embed.FS
+
+var templates = template.Must(template.ParseFS(templateFS, "templates/*.tmpl"))
+
+// Render executes templates/<name>.<channel>.tmpl. Email templates define a
+// "<name>.email.subject" block; the rest of the output is the body.
+func Render(channel Channel, name string, data interface{}) (string, string, error) {
+	base := name + "." + string(channel)
+	tmpl := templates.Lookup(base + ".tmpl")
+	if tmpl == nil {
+		return "", "", fmt.Errorf("notification template %s not found", base)
+	}
+
+	var body bytes.Buffer
+	if err := tmpl.Execute(&body, data); err != nil {
+		return "", "", err
+	}
+
+	var subject bytes.Buffer
+	if sub := templates.Lookup(base + ".subject"); sub != nil {
+		if err := sub.Execute(&subject, data); err != nil {
+			return "", "", err
+		}
+	}
+
+	return strings.TrimSpace(subject.String()), strings.TrimSpace(body.String()), nil
+}
@@ -0,0 +1,227 @@
+package password
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/config"
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+// Names of the supported hashing algorithms
+const (
+	AlgorithmBcrypt   = "bcrypt"
+	AlgorithmArgon2id = "argon2id"
+)
+
+// ErrUnknownHash is returned for stored hashes no algorithm recognises
+var ErrUnknownHash = errors.New("unrecognised password hash format")
+
+// Algorithm hashes passwords into a self describing string that records the
+// algorithm and the parameters it was created with
+type Algorithm interface {
+	Name() string
+	Hash(password string) (string, error)
+	// Recognises reports whether the encoded hash was produced by this algorithm
+	Recognises(encoded string) bool
+	Verify(encoded, password string) (bool, error)
+	// Weaker reports whether the encoded hash, which must be recognised,
+	// uses cheaper parameters than the algorithm is configured with
+	Weaker(encoded string) bool
+}
+
+// Hasher hashes new passwords with the configured algorithm and verifies
+// hashes made by any supported algorithm
+type Hasher struct {
+	current    Algorithm
+	algorithms []Algorithm
+}
+
+// NewHasher creates a hasher using the algorithm and parameters in the config
+func NewHasher(cfg config.Config) (*Hasher, error) {
+	bcryptAlg := &Bcrypt{Cost: cfg.BCRYPT_COST}
+	argonAlg := &Argon2id{
+		Memory:      cfg.ARGON2_MEMORY,
+		Iterations:  cfg.ARGON2_ITERATIONS,
+		Parallelism: cfg.ARGON2_PARALLELISM,
+		SaltLength:  16,
+		KeyLength:   32,
+	}
+
+	if bcryptAlg.Cost < bcrypt.MinCost || bcryptAlg.Cost > bcrypt.MaxCost {
+		return nil, fmt.Errorf("BCRYPT_COST must be between %d and %d", bcrypt.MinCost, bcrypt.MaxCost)
+	}
+	if argonAlg.Memory == 0 || argonAlg.Iterations == 0 || argonAlg.Parallelism == 0 {
+		return nil, errors.New("ARGON2_MEMORY, ARGON2_ITERATIONS and ARGON2_PARALLELISM must be set")
+	}
+
+	h := &Hasher{algorithms: []Algorithm{bcryptAlg, argonAlg}}
+	for _, alg := range h.algorithms {
+		if alg.Name() == cfg.PASSWORD_HASH_ALGORITHM {
+			h.current = alg
+		}
+	}
+	if h.current == nil {
+		return nil, fmt.Errorf("unsupported PASSWORD_HASH_ALGORITHM %q", cfg.PASSWORD_HASH_ALGORITHM)
+	}
+
+	return h, nil
+}
+
+// Hash hashes the password with the current algorithm
+func (h *Hasher) Hash(password string) (string, error) {
+	return h.current.Hash(password)
+}
+
+// Verify reports whether the password matches the encoded hash
+func (h *Hasher) Verify(encoded, password string) bool {
+	alg := h.algorithm(encoded)
+	if alg == nil {
+		return false
+	}
+	ok, err := alg.Verify(encoded, password)
+	return err == nil && ok
+}
+
+// NeedsRehash reports whether the encoded hash should be replaced by one made
+// with the current algorithm and parameters
+func (h *Hasher) NeedsRehash(encoded string) bool {
+	alg := h.algorithm(encoded)
+	if alg == nil {
+		return true
+	}
+	return alg.Name() != h.current.Name() || alg.Weaker(encoded)
+}
+
+func (h *Hasher) algorithm(encoded string) Algorithm {
+	for _, alg := range h.algorithms {
+		if alg.Recognises(encoded) {
+			return alg
+		}
+	}
+	return nil
+}
+
+// Bcrypt hashes are stored in the standard $2a$<cost>$ format
+type Bcrypt struct {
+	Cost int
+}
+
+func (b *Bcrypt) Name() string {
+	return AlgorithmBcrypt
+}
+
+func (b *Bcrypt) Hash(password string) (string, error) {
+	bytes, err := bcrypt.GenerateFromPassword([]byte(password), b.Cost)
+	return string(bytes), err
+}
+
+func (b *Bcrypt) Recognises(encoded string) bool {
+	return strings.HasPrefix(encoded, "$2a$") || strings.HasPrefix(encoded, "$2b$") || strings.HasPrefix(encoded, "$2y$")
+}
+
+func (b *Bcrypt) Verify(encoded, password string) (bool, error) {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+func (b *Bcrypt) Weaker(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	return err != nil || cost < b.Cost
+}
+
+// Argon2id hashes are stored in the PHC string format,
+// $argon2id$v=19$m=<memory>,t=<iterations>,p=<parallelism>$<salt>$<key>
+type Argon2id struct {
+	// Memory in KiB
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  int
+	KeyLength   uint32
+}
+
+type argon2Hash struct {
+	memory      uint32
+	iterations  uint32
+	parallelism uint8
+	salt        []byte
+	key         []byte
+}
+
+func (a *Argon2id) Name() string {
+	return AlgorithmArgon2id
+}
+
+func (a *Argon2id) Hash(password string) (string, error) {
+	salt := make([]byte, a.SaltLength)
+	if _, err := rand.Read(salt); err != nil {
+		return "", err
+	}
+	key := argon2.IDKey([]byte(password), salt, a.Iterations, a.Memory, a.Parallelism, a.KeyLength)
+
+	return fmt.Sprintf("$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, a.Memory, a.Iterations, a.Parallelism,
+		base64.RawStdEncoding.EncodeToString(salt),
+		base64.RawStdEncoding.EncodeToString(key)), nil
+}
+
+func (a *Argon2id) Recognises(encoded string) bool {
+	return strings.HasPrefix(encoded, "$argon2id$")
+}
+
+func (a *Argon2id) Verify(encoded, password string) (bool, error) {
+	parsed, err := parseArgon2(encoded)
+	if err != nil {
+		return false, err
+	}
+	key := argon2.IDKey([]byte(password), parsed.salt, parsed.iterations, parsed.memory, parsed.parallelism, uint32(len(parsed.key)))
+
+	return subtle.ConstantTimeCompare(key, parsed.key) == 1, nil
+}
+
+func (a *Argon2id) Weaker(encoded string) bool {
+	parsed, err := parseArgon2(encoded)
+	if err != nil {
+		return true
+	}
+	return parsed.memory < a.Memory ||
+		parsed.iterations < a.Iterations ||
+		parsed.parallelism < a.Parallelism ||
+		len(parsed.key) < int(a.KeyLength)
+}
+
+func parseArgon2(encoded string) (*argon2Hash, error) {
+	parts := strings.Split(encoded, "$")
+	if len(parts) != 6 || parts[1] != AlgorithmArgon2id {
+		return nil, ErrUnknownHash
+	}
+
+	var version int
+	if _, err := fmt.Sscanf(parts[2], "v=%d", &version); err != nil || version != argon2.Version {
+		return nil, ErrUnknownHash
+	}
+
+	parsed := &argon2Hash{}
+	_, err := fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &parsed.memory, &parsed.iterations, &parsed.parallelism)
+	if err != nil {
+		return nil, ErrUnknownHash
+	}
+
+	if parsed.salt, err = base64.RawStdEncoding.DecodeString(parts[4]); err != nil {
+		return nil, ErrUnknownHash
+	}
+	if parsed.key, err = base64.RawStdEncoding.DecodeString(parts[5]); err != nil || len(parsed.key) == 0 {
+		return nil, ErrUnknownHash
+	}
+
+	return parsed, nil
+}
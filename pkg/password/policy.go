@@ -0,0 +1,156 @@
+package password
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/config"
+)
+
+// Rule names reported in violations
+const (
+	RuleMinLength = "min_length"
+	RuleMaxLength = "max_length"
+	RuleUpper     = "uppercase"
+	RuleLower     = "lowercase"
+	RuleDigit     = "digit"
+	RuleSymbol    = "symbol"
+	RuleUserInfo  = "user_info"
+	RuleBreached  = "breached"
+	RuleHistory   = "history"
+)
+
+// Violation is a single rule a password failed
+type Violation struct {
+	Rule    string
+	Message string
+}
+
+// Policy holds the rules every new password is checked against
+type Policy struct {
+	MinLength        int
+	MaxLength        int
+	RequireUpper     bool
+	RequireLower     bool
+	RequireDigit     bool
+	RequireSymbol    bool
+	DisallowUserInfo bool
+	// Number of previous password hashes a new password may not match
+	HistorySize int
+
+	breached map[string]struct{}
+}
+
+// NewPolicy builds the policy from the config, loading the breached password
+// list if one is configured
+func NewPolicy(cfg config.Config) (*Policy, error) {
+	p := &Policy{
+		MinLength:        cfg.PASSWORD_MIN_LENGTH,
+		MaxLength:        cfg.PASSWORD_MAX_LENGTH,
+		RequireUpper:     cfg.PASSWORD_REQUIRE_UPPER,
+		RequireLower:     cfg.PASSWORD_REQUIRE_LOWER,
+		RequireDigit:     cfg.PASSWORD_REQUIRE_DIGIT,
+		RequireSymbol:    cfg.PASSWORD_REQUIRE_SYMBOL,
+		DisallowUserInfo: cfg.PASSWORD_DISALLOW_USER_INFO,
+		HistorySize:      cfg.PASSWORD_HISTORY_SIZE,
+		breached:         map[string]struct{}{},
+	}
+
+	if cfg.PASSWORD_BREACHED_LIST_PATH != "" {
+		if err := p.loadBreached(cfg.PASSWORD_BREACHED_LIST_PATH); err != nil {
+			return nil, err
+		}
+	}
+
+	return p, nil
+}
+
+// loadBreached reads one password per line. Matching is case insensitive.
+func (p *Policy) loadBreached(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line != "" {
+			p.breached[strings.ToLower(line)] = struct{}{}
+		}
+	}
+	return scanner.Err()
+}
+
+// Check returns every rule the password breaks. userInfo holds values such
+// as the username and email the password must not contain.
+func (p *Policy) Check(password string, userInfo ...string) []Violation {
+	var violations []Violation
+
+	length := utf8.RuneCountInString(password)
+	if length < p.MinLength {
+		violations = append(violations, Violation{RuleMinLength,
+			fmt.Sprintf("must be at least %d characters", p.MinLength)})
+	}
+	if p.MaxLength > 0 && length > p.MaxLength {
+		violations = append(violations, Violation{RuleMaxLength,
+			fmt.Sprintf("must be at most %d characters", p.MaxLength)})
+	}
+
+	var hasUpper, hasLower, hasDigit, hasSymbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			hasUpper = true
+		case unicode.IsLower(r):
+			hasLower = true
+		case unicode.IsDigit(r):
+			hasDigit = true
+		case unicode.IsPunct(r) || unicode.IsSymbol(r) || unicode.IsSpace(r):
+			hasSymbol = true
+		}
+	}
+	if p.RequireUpper && !hasUpper {
+		violations = append(violations, Violation{RuleUpper, "must contain an uppercase letter"})
+	}
+	if p.RequireLower && !hasLower {
+		violations = append(violations, Violation{RuleLower, "must contain a lowercase letter"})
+	}
+	if p.RequireDigit && !hasDigit {
+		violations = append(violations, Violation{RuleDigit, "must contain a digit"})
+	}
+	if p.RequireSymbol && !hasSymbol {
+		violations = append(violations, Violation{RuleSymbol, "must contain a symbol"})
+	}
+
+	if p.DisallowUserInfo && containsUserInfo(password, userInfo) {
+		violations = append(violations, Violation{RuleUserInfo, "must not contain your username or email"})
+	}
+
+	if _, ok := p.breached[strings.ToLower(password)]; ok {
+		violations = append(violations, Violation{RuleBreached, "has appeared in a data breach and can't be used"})
+	}
+
+	return violations
+}
+
+func containsUserInfo(password string, userInfo []string) bool {
+	lower := strings.ToLower(password)
+	for _, info := range userInfo {
+		// Only the local part of an email is something people put in passwords
+		if at := strings.Index(info, "@"); at > 0 {
+			info = info[:at]
+		}
+		info = strings.ToLower(strings.TrimSpace(info))
+		// Very short values would match far too many passwords by chance
+		if len(info) >= 3 && strings.Contains(lower, info) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,7610 @@
+package model
+
+import (
+	context "context"
+	fmt "fmt"
+	gorm1 "github.com/infobloxopen/atlas-app-toolkit/gorm"
+	errors "github.com/infobloxopen/protoc-gen-gorm/errors"
+	gorm "github.com/jinzhu/gorm"
+	field_mask "google.golang.org/genproto/protobuf/field_mask"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	strings "strings"
+	time "time"
+)
+
+type RefreshTokenORM struct {
+	CreatedAt *time.Time
+	ExpiresAt *time.Time
+	FamilyId  string `gorm:"index:idx_refresh_tokens_family_id"`
+	Id        string `gorm:"type:uuid;primary_key"`
+	RevokedAt *time.Time
+	TokenHash string `gorm:"unique"`
+	UsedAt    *time.Time
+	User      *UserORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	UserId    *string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (RefreshTokenORM) TableName() string {
+	return "refresh_tokens"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *RefreshToken) ToORM(ctx context.Context) (RefreshTokenORM, error) {
+	to := RefreshTokenORM{}
+	var err error
+	if prehook, ok := interface{}(m).(RefreshTokenWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.TokenHash = m.TokenHash
+	to.FamilyId = m.FamilyId
+	if m.ExpiresAt != nil {
+		t := m.ExpiresAt.AsTime()
+		to.ExpiresAt = &t
+	}
+	if m.UsedAt != nil {
+		t := m.UsedAt.AsTime()
+		to.UsedAt = &t
+	}
+	if m.RevokedAt != nil {
+		t := m.RevokedAt.AsTime()
+		to.RevokedAt = &t
+	}
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(RefreshTokenWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *RefreshTokenORM) ToPB(ctx context.Context) (RefreshToken, error) {
+	to := RefreshToken{}
+	var err error
+	if prehook, ok := interface{}(m).(RefreshTokenWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.TokenHash = m.TokenHash
+	to.FamilyId = m.FamilyId
+	if m.ExpiresAt != nil {
+		to.ExpiresAt = timestamppb.New(*m.ExpiresAt)
+	}
+	if m.UsedAt != nil {
+		to.UsedAt = timestamppb.New(*m.UsedAt)
+	}
+	if m.RevokedAt != nil {
+		to.RevokedAt = timestamppb.New(*m.RevokedAt)
+	}
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(RefreshTokenWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type RefreshToken the arg will be the target, the caller the one being converted from
+
+// RefreshTokenBeforeToORM called before default ToORM code
+type RefreshTokenWithBeforeToORM interface {
+	BeforeToORM(context.Context, *RefreshTokenORM) error
+}
+
+// RefreshTokenAfterToORM called after default ToORM code
+type RefreshTokenWithAfterToORM interface {
+	AfterToORM(context.Context, *RefreshTokenORM) error
+}
+
+// RefreshTokenBeforeToPB called before default ToPB code
+type RefreshTokenWithBeforeToPB interface {
+	BeforeToPB(context.Context, *RefreshToken) error
+}
+
+// RefreshTokenAfterToPB called after default ToPB code
+type RefreshTokenWithAfterToPB interface {
+	AfterToPB(context.Context, *RefreshToken) error
+}
+
+type SessionORM struct {
+	CreatedAt  *time.Time
+	Device     string
+	Id         string `gorm:"type:uuid;primary_key"`
+	IpAddress  string
+	LastSeenAt *time.Time
+	RevokedAt  *time.Time
+	User       *UserORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	UserAgent  string
+	UserId     *string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (SessionORM) TableName() string {
+	return "sessions"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Session) ToORM(ctx context.Context) (SessionORM, error) {
+	to := SessionORM{}
+	var err error
+	if prehook, ok := interface{}(m).(SessionWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.Device = m.Device
+	to.IpAddress = m.IpAddress
+	to.UserAgent = m.UserAgent
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.LastSeenAt != nil {
+		t := m.LastSeenAt.AsTime()
+		to.LastSeenAt = &t
+	}
+	if m.RevokedAt != nil {
+		t := m.RevokedAt.AsTime()
+		to.RevokedAt = &t
+	}
+	if posthook, ok := interface{}(m).(SessionWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *SessionORM) ToPB(ctx context.Context) (Session, error) {
+	to := Session{}
+	var err error
+	if prehook, ok := interface{}(m).(SessionWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.Device = m.Device
+	to.IpAddress = m.IpAddress
+	to.UserAgent = m.UserAgent
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.LastSeenAt != nil {
+		to.LastSeenAt = timestamppb.New(*m.LastSeenAt)
+	}
+	if m.RevokedAt != nil {
+		to.RevokedAt = timestamppb.New(*m.RevokedAt)
+	}
+	if posthook, ok := interface{}(m).(SessionWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Session the arg will be the target, the caller the one being converted from
+
+// SessionBeforeToORM called before default ToORM code
+type SessionWithBeforeToORM interface {
+	BeforeToORM(context.Context, *SessionORM) error
+}
+
+// SessionAfterToORM called after default ToORM code
+type SessionWithAfterToORM interface {
+	AfterToORM(context.Context, *SessionORM) error
+}
+
+// SessionBeforeToPB called before default ToPB code
+type SessionWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Session) error
+}
+
+// SessionAfterToPB called after default ToPB code
+type SessionWithAfterToPB interface {
+	AfterToPB(context.Context, *Session) error
+}
+
+type TotpCredentialORM struct {
+	ConfirmedAt     *time.Time
+	CreatedAt       *time.Time
+	EncryptedSecret string
+	Id              string `gorm:"type:uuid;primary_key"`
+	LastUsedStep    int64
+	User            *UserORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	UserId          *string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (TotpCredentialORM) TableName() string {
+	return "totp_credentials"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *TotpCredential) ToORM(ctx context.Context) (TotpCredentialORM, error) {
+	to := TotpCredentialORM{}
+	var err error
+	if prehook, ok := interface{}(m).(TotpCredentialWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.EncryptedSecret = m.EncryptedSecret
+	to.LastUsedStep = m.LastUsedStep
+	if m.ConfirmedAt != nil {
+		t := m.ConfirmedAt.AsTime()
+		to.ConfirmedAt = &t
+	}
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(TotpCredentialWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *TotpCredentialORM) ToPB(ctx context.Context) (TotpCredential, error) {
+	to := TotpCredential{}
+	var err error
+	if prehook, ok := interface{}(m).(TotpCredentialWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.EncryptedSecret = m.EncryptedSecret
+	to.LastUsedStep = m.LastUsedStep
+	if m.ConfirmedAt != nil {
+		to.ConfirmedAt = timestamppb.New(*m.ConfirmedAt)
+	}
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(TotpCredentialWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type TotpCredential the arg will be the target, the caller the one being converted from
+
+// TotpCredentialBeforeToORM called before default ToORM code
+type TotpCredentialWithBeforeToORM interface {
+	BeforeToORM(context.Context, *TotpCredentialORM) error
+}
+
+// TotpCredentialAfterToORM called after default ToORM code
+type TotpCredentialWithAfterToORM interface {
+	AfterToORM(context.Context, *TotpCredentialORM) error
+}
+
+// TotpCredentialBeforeToPB called before default ToPB code
+type TotpCredentialWithBeforeToPB interface {
+	BeforeToPB(context.Context, *TotpCredential) error
+}
+
+// TotpCredentialAfterToPB called after default ToPB code
+type TotpCredentialWithAfterToPB interface {
+	AfterToPB(context.Context, *TotpCredential) error
+}
+
+type RecoveryCodeORM struct {
+	CodeHash  string
+	CreatedAt *time.Time
+	Id        string `gorm:"type:uuid;primary_key"`
+	UsedAt    *time.Time
+	User      *UserORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	UserId    *string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (RecoveryCodeORM) TableName() string {
+	return "recovery_codes"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *RecoveryCode) ToORM(ctx context.Context) (RecoveryCodeORM, error) {
+	to := RecoveryCodeORM{}
+	var err error
+	if prehook, ok := interface{}(m).(RecoveryCodeWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.CodeHash = m.CodeHash
+	if m.UsedAt != nil {
+		t := m.UsedAt.AsTime()
+		to.UsedAt = &t
+	}
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(RecoveryCodeWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *RecoveryCodeORM) ToPB(ctx context.Context) (RecoveryCode, error) {
+	to := RecoveryCode{}
+	var err error
+	if prehook, ok := interface{}(m).(RecoveryCodeWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.CodeHash = m.CodeHash
+	if m.UsedAt != nil {
+		to.UsedAt = timestamppb.New(*m.UsedAt)
+	}
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(RecoveryCodeWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type RecoveryCode the arg will be the target, the caller the one being converted from
+
+// RecoveryCodeBeforeToORM called before default ToORM code
+type RecoveryCodeWithBeforeToORM interface {
+	BeforeToORM(context.Context, *RecoveryCodeORM) error
+}
+
+// RecoveryCodeAfterToORM called after default ToORM code
+type RecoveryCodeWithAfterToORM interface {
+	AfterToORM(context.Context, *RecoveryCodeORM) error
+}
+
+// RecoveryCodeBeforeToPB called before default ToPB code
+type RecoveryCodeWithBeforeToPB interface {
+	BeforeToPB(context.Context, *RecoveryCode) error
+}
+
+// RecoveryCodeAfterToPB called after default ToPB code
+type RecoveryCodeWithAfterToPB interface {
+	AfterToPB(context.Context, *RecoveryCode) error
+}
+
+type OtpORM struct {
+	Attempts   int32
+	CodeHash   string
+	ConsumedAt *time.Time
+	CreatedAt  *time.Time
+	ExpiresAt  *time.Time
+	Id         string `gorm:"type:uuid;primary_key"`
+	Purpose    int32
+	User       *UserORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	UserId     *string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (OtpORM) TableName() string {
+	return "otps"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Otp) ToORM(ctx context.Context) (OtpORM, error) {
+	to := OtpORM{}
+	var err error
+	if prehook, ok := interface{}(m).(OtpWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.Purpose = int32(m.Purpose)
+	to.CodeHash = m.CodeHash
+	to.Attempts = m.Attempts
+	if m.ExpiresAt != nil {
+		t := m.ExpiresAt.AsTime()
+		to.ExpiresAt = &t
+	}
+	if m.ConsumedAt != nil {
+		t := m.ConsumedAt.AsTime()
+		to.ConsumedAt = &t
+	}
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(OtpWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *OtpORM) ToPB(ctx context.Context) (Otp, error) {
+	to := Otp{}
+	var err error
+	if prehook, ok := interface{}(m).(OtpWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.Purpose = OtpPurpose(m.Purpose)
+	to.CodeHash = m.CodeHash
+	to.Attempts = m.Attempts
+	if m.ExpiresAt != nil {
+		to.ExpiresAt = timestamppb.New(*m.ExpiresAt)
+	}
+	if m.ConsumedAt != nil {
+		to.ConsumedAt = timestamppb.New(*m.ConsumedAt)
+	}
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(OtpWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Otp the arg will be the target, the caller the one being converted from
+
+// OtpBeforeToORM called before default ToORM code
+type OtpWithBeforeToORM interface {
+	BeforeToORM(context.Context, *OtpORM) error
+}
+
+// OtpAfterToORM called after default ToORM code
+type OtpWithAfterToORM interface {
+	AfterToORM(context.Context, *OtpORM) error
+}
+
+// OtpBeforeToPB called before default ToPB code
+type OtpWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Otp) error
+}
+
+// OtpAfterToPB called after default ToPB code
+type OtpWithAfterToPB interface {
+	AfterToPB(context.Context, *Otp) error
+}
+
+type PasswordHistoryORM struct {
+	CreatedAt    *time.Time
+	Id           string `gorm:"type:uuid;primary_key"`
+	PasswordHash string
+	User         *UserORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	UserId       *string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (PasswordHistoryORM) TableName() string {
+	return "password_histories"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *PasswordHistory) ToORM(ctx context.Context) (PasswordHistoryORM, error) {
+	to := PasswordHistoryORM{}
+	var err error
+	if prehook, ok := interface{}(m).(PasswordHistoryWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.PasswordHash = m.PasswordHash
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(PasswordHistoryWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *PasswordHistoryORM) ToPB(ctx context.Context) (PasswordHistory, error) {
+	to := PasswordHistory{}
+	var err error
+	if prehook, ok := interface{}(m).(PasswordHistoryWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.PasswordHash = m.PasswordHash
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(PasswordHistoryWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type PasswordHistory the arg will be the target, the caller the one being converted from
+
+// PasswordHistoryBeforeToORM called before default ToORM code
+type PasswordHistoryWithBeforeToORM interface {
+	BeforeToORM(context.Context, *PasswordHistoryORM) error
+}
+
+// PasswordHistoryAfterToORM called after default ToORM code
+type PasswordHistoryWithAfterToORM interface {
+	AfterToORM(context.Context, *PasswordHistoryORM) error
+}
+
+// PasswordHistoryBeforeToPB called before default ToPB code
+type PasswordHistoryWithBeforeToPB interface {
+	BeforeToPB(context.Context, *PasswordHistory) error
+}
+
+// PasswordHistoryAfterToPB called after default ToPB code
+type PasswordHistoryWithAfterToPB interface {
+	AfterToPB(context.Context, *PasswordHistory) error
+}
+
+type LoginThrottleORM struct {
+	BlockedUntil  *time.Time
+	Failures      int32
+	Key           string `gorm:"primary_key"`
+	LastFailureAt *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (LoginThrottleORM) TableName() string {
+	return "login_throttles"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *LoginThrottle) ToORM(ctx context.Context) (LoginThrottleORM, error) {
+	to := LoginThrottleORM{}
+	var err error
+	if prehook, ok := interface{}(m).(LoginThrottleWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Key = m.Key
+	to.Failures = m.Failures
+	if m.LastFailureAt != nil {
+		t := m.LastFailureAt.AsTime()
+		to.LastFailureAt = &t
+	}
+	if m.BlockedUntil != nil {
+		t := m.BlockedUntil.AsTime()
+		to.BlockedUntil = &t
+	}
+	if posthook, ok := interface{}(m).(LoginThrottleWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *LoginThrottleORM) ToPB(ctx context.Context) (LoginThrottle, error) {
+	to := LoginThrottle{}
+	var err error
+	if prehook, ok := interface{}(m).(LoginThrottleWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Key = m.Key
+	to.Failures = m.Failures
+	if m.LastFailureAt != nil {
+		to.LastFailureAt = timestamppb.New(*m.LastFailureAt)
+	}
+	if m.BlockedUntil != nil {
+		to.BlockedUntil = timestamppb.New(*m.BlockedUntil)
+	}
+	if posthook, ok := interface{}(m).(LoginThrottleWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type LoginThrottle the arg will be the target, the caller the one being converted from
+
+// LoginThrottleBeforeToORM called before default ToORM code
+type LoginThrottleWithBeforeToORM interface {
+	BeforeToORM(context.Context, *LoginThrottleORM) error
+}
+
+// LoginThrottleAfterToORM called after default ToORM code
+type LoginThrottleWithAfterToORM interface {
+	AfterToORM(context.Context, *LoginThrottleORM) error
+}
+
+// LoginThrottleBeforeToPB called before default ToPB code
+type LoginThrottleWithBeforeToPB interface {
+	BeforeToPB(context.Context, *LoginThrottle) error
+}
+
+// LoginThrottleAfterToPB called after default ToPB code
+type LoginThrottleWithAfterToPB interface {
+	AfterToPB(context.Context, *LoginThrottle) error
+}
+
+type LinkedIdentityORM struct {
+	Email    string
+	Id       string `gorm:"type:uuid;primary_key"`
+	LinkedAt *time.Time
+	Provider string   `gorm:"index:idx_linked_identities_provider_subject,unique"`
+	Subject  string   `gorm:"index:idx_linked_identities_provider_subject,unique"`
+	User     *UserORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	UserId   *string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (LinkedIdentityORM) TableName() string {
+	return "linked_identities"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *LinkedIdentity) ToORM(ctx context.Context) (LinkedIdentityORM, error) {
+	to := LinkedIdentityORM{}
+	var err error
+	if prehook, ok := interface{}(m).(LinkedIdentityWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.Provider = m.Provider
+	to.Subject = m.Subject
+	to.Email = m.Email
+	if m.LinkedAt != nil {
+		t := m.LinkedAt.AsTime()
+		to.LinkedAt = &t
+	}
+	if posthook, ok := interface{}(m).(LinkedIdentityWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *LinkedIdentityORM) ToPB(ctx context.Context) (LinkedIdentity, error) {
+	to := LinkedIdentity{}
+	var err error
+	if prehook, ok := interface{}(m).(LinkedIdentityWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.Provider = m.Provider
+	to.Subject = m.Subject
+	to.Email = m.Email
+	if m.LinkedAt != nil {
+		to.LinkedAt = timestamppb.New(*m.LinkedAt)
+	}
+	if posthook, ok := interface{}(m).(LinkedIdentityWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type LinkedIdentity the arg will be the target, the caller the one being converted from
+
+// LinkedIdentityBeforeToORM called before default ToORM code
+type LinkedIdentityWithBeforeToORM interface {
+	BeforeToORM(context.Context, *LinkedIdentityORM) error
+}
+
+// LinkedIdentityAfterToORM called after default ToORM code
+type LinkedIdentityWithAfterToORM interface {
+	AfterToORM(context.Context, *LinkedIdentityORM) error
+}
+
+// LinkedIdentityBeforeToPB called before default ToPB code
+type LinkedIdentityWithBeforeToPB interface {
+	BeforeToPB(context.Context, *LinkedIdentity) error
+}
+
+// LinkedIdentityAfterToPB called after default ToPB code
+type LinkedIdentityWithAfterToPB interface {
+	AfterToPB(context.Context, *LinkedIdentity) error
+}
+
+type PasskeyCredentialORM struct {
+	Aaguid       string
+	CreatedAt    *time.Time
+	CredentialId string `gorm:"unique"`
+	Id           string `gorm:"type:uuid;primary_key"`
+	LastUsedAt   *time.Time
+	Name         string
+	PublicKey    string
+	SignCount    int64
+	User         *UserORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	UserId       *string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (PasskeyCredentialORM) TableName() string {
+	return "passkey_credentials"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *PasskeyCredential) ToORM(ctx context.Context) (PasskeyCredentialORM, error) {
+	to := PasskeyCredentialORM{}
+	var err error
+	if prehook, ok := interface{}(m).(PasskeyCredentialWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.CredentialId = m.CredentialId
+	to.PublicKey = m.PublicKey
+	to.SignCount = m.SignCount
+	to.Aaguid = m.Aaguid
+	to.Name = m.Name
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.LastUsedAt != nil {
+		t := m.LastUsedAt.AsTime()
+		to.LastUsedAt = &t
+	}
+	if posthook, ok := interface{}(m).(PasskeyCredentialWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *PasskeyCredentialORM) ToPB(ctx context.Context) (PasskeyCredential, error) {
+	to := PasskeyCredential{}
+	var err error
+	if prehook, ok := interface{}(m).(PasskeyCredentialWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.CredentialId = m.CredentialId
+	to.PublicKey = m.PublicKey
+	to.SignCount = m.SignCount
+	to.Aaguid = m.Aaguid
+	to.Name = m.Name
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.LastUsedAt != nil {
+		to.LastUsedAt = timestamppb.New(*m.LastUsedAt)
+	}
+	if posthook, ok := interface{}(m).(PasskeyCredentialWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type PasskeyCredential the arg will be the target, the caller the one being converted from
+
+// PasskeyCredentialBeforeToORM called before default ToORM code
+type PasskeyCredentialWithBeforeToORM interface {
+	BeforeToORM(context.Context, *PasskeyCredentialORM) error
+}
+
+// PasskeyCredentialAfterToORM called after default ToORM code
+type PasskeyCredentialWithAfterToORM interface {
+	AfterToORM(context.Context, *PasskeyCredentialORM) error
+}
+
+// PasskeyCredentialBeforeToPB called before default ToPB code
+type PasskeyCredentialWithBeforeToPB interface {
+	BeforeToPB(context.Context, *PasskeyCredential) error
+}
+
+// PasskeyCredentialAfterToPB called after default ToPB code
+type PasskeyCredentialWithAfterToPB interface {
+	AfterToPB(context.Context, *PasskeyCredential) error
+}
+
+type WebauthnChallengeORM struct {
+	Ceremony      string
+	ChallengeHash string `gorm:"unique"`
+	ConsumedAt    *time.Time
+	CreatedAt     *time.Time
+	ExpiresAt     *time.Time
+	Id            string   `gorm:"type:uuid;primary_key"`
+	User          *UserORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	UserId        *string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (WebauthnChallengeORM) TableName() string {
+	return "webauthn_challenges"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *WebauthnChallenge) ToORM(ctx context.Context) (WebauthnChallengeORM, error) {
+	to := WebauthnChallengeORM{}
+	var err error
+	if prehook, ok := interface{}(m).(WebauthnChallengeWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.ChallengeHash = m.ChallengeHash
+	to.Ceremony = m.Ceremony
+	if m.ExpiresAt != nil {
+		t := m.ExpiresAt.AsTime()
+		to.ExpiresAt = &t
+	}
+	if m.ConsumedAt != nil {
+		t := m.ConsumedAt.AsTime()
+		to.ConsumedAt = &t
+	}
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(WebauthnChallengeWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *WebauthnChallengeORM) ToPB(ctx context.Context) (WebauthnChallenge, error) {
+	to := WebauthnChallenge{}
+	var err error
+	if prehook, ok := interface{}(m).(WebauthnChallengeWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.ChallengeHash = m.ChallengeHash
+	to.Ceremony = m.Ceremony
+	if m.ExpiresAt != nil {
+		to.ExpiresAt = timestamppb.New(*m.ExpiresAt)
+	}
+	if m.ConsumedAt != nil {
+		to.ConsumedAt = timestamppb.New(*m.ConsumedAt)
+	}
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(WebauthnChallengeWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type WebauthnChallenge the arg will be the target, the caller the one being converted from
+
+// WebauthnChallengeBeforeToORM called before default ToORM code
+type WebauthnChallengeWithBeforeToORM interface {
+	BeforeToORM(context.Context, *WebauthnChallengeORM) error
+}
+
+// WebauthnChallengeAfterToORM called after default ToORM code
+type WebauthnChallengeWithAfterToORM interface {
+	AfterToORM(context.Context, *WebauthnChallengeORM) error
+}
+
+// WebauthnChallengeBeforeToPB called before default ToPB code
+type WebauthnChallengeWithBeforeToPB interface {
+	BeforeToPB(context.Context, *WebauthnChallenge) error
+}
+
+// WebauthnChallengeAfterToPB called after default ToPB code
+type WebauthnChallengeWithAfterToPB interface {
+	AfterToPB(context.Context, *WebauthnChallenge) error
+}
+
+type ClientORM struct {
+	CreatedAt       *time.Time
+	Id              string `gorm:"type:uuid;primary_key"`
+	Name            string
+	RedirectUris    string
+	Scopes          string
+	SecretHash      string
+	SecretRotatedAt *time.Time
+	Status          int32
+	UpdatedAt       *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ClientORM) TableName() string {
+	return "clients"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Client) ToORM(ctx context.Context) (ClientORM, error) {
+	to := ClientORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ClientWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.SecretHash = m.SecretHash
+	to.Scopes = m.Scopes
+	to.Status = int32(m.Status)
+	if m.SecretRotatedAt != nil {
+		t := m.SecretRotatedAt.AsTime()
+		to.SecretRotatedAt = &t
+	}
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	to.RedirectUris = m.RedirectUris
+	if posthook, ok := interface{}(m).(ClientWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ClientORM) ToPB(ctx context.Context) (Client, error) {
+	to := Client{}
+	var err error
+	if prehook, ok := interface{}(m).(ClientWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.SecretHash = m.SecretHash
+	to.Scopes = m.Scopes
+	to.Status = Status(m.Status)
+	if m.SecretRotatedAt != nil {
+		to.SecretRotatedAt = timestamppb.New(*m.SecretRotatedAt)
+	}
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	to.RedirectUris = m.RedirectUris
+	if posthook, ok := interface{}(m).(ClientWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Client the arg will be the target, the caller the one being converted from
+
+// ClientBeforeToORM called before default ToORM code
+type ClientWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ClientORM) error
+}
+
+// ClientAfterToORM called after default ToORM code
+type ClientWithAfterToORM interface {
+	AfterToORM(context.Context, *ClientORM) error
+}
+
+// ClientBeforeToPB called before default ToPB code
+type ClientWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Client) error
+}
+
+// ClientAfterToPB called after default ToPB code
+type ClientWithAfterToPB interface {
+	AfterToPB(context.Context, *Client) error
+}
+
+type AuthorizationCodeORM struct {
+	AuthTime      *time.Time
+	Client        *ClientORM `gorm:"foreignkey:ClientId;association_foreignkey:Id"`
+	ClientId      *string
+	CodeChallenge string
+	CodeHash      string `gorm:"unique"`
+	ConsumedAt    *time.Time
+	CreatedAt     *time.Time
+	ExpiresAt     *time.Time
+	Id            string `gorm:"type:uuid;primary_key"`
+	Nonce         string
+	RedirectUri   string
+	Scope         string
+	User          *UserORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	UserId        *string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (AuthorizationCodeORM) TableName() string {
+	return "authorization_codes"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *AuthorizationCode) ToORM(ctx context.Context) (AuthorizationCodeORM, error) {
+	to := AuthorizationCodeORM{}
+	var err error
+	if prehook, ok := interface{}(m).(AuthorizationCodeWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Client != nil {
+		tempClient, err := m.Client.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Client = &tempClient
+	}
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.CodeHash = m.CodeHash
+	to.RedirectUri = m.RedirectUri
+	to.Scope = m.Scope
+	to.Nonce = m.Nonce
+	to.CodeChallenge = m.CodeChallenge
+	if m.AuthTime != nil {
+		t := m.AuthTime.AsTime()
+		to.AuthTime = &t
+	}
+	if m.ExpiresAt != nil {
+		t := m.ExpiresAt.AsTime()
+		to.ExpiresAt = &t
+	}
+	if m.ConsumedAt != nil {
+		t := m.ConsumedAt.AsTime()
+		to.ConsumedAt = &t
+	}
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(AuthorizationCodeWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *AuthorizationCodeORM) ToPB(ctx context.Context) (AuthorizationCode, error) {
+	to := AuthorizationCode{}
+	var err error
+	if prehook, ok := interface{}(m).(AuthorizationCodeWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.Client != nil {
+		tempClient, err := m.Client.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Client = &tempClient
+	}
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.CodeHash = m.CodeHash
+	to.RedirectUri = m.RedirectUri
+	to.Scope = m.Scope
+	to.Nonce = m.Nonce
+	to.CodeChallenge = m.CodeChallenge
+	if m.AuthTime != nil {
+		to.AuthTime = timestamppb.New(*m.AuthTime)
+	}
+	if m.ExpiresAt != nil {
+		to.ExpiresAt = timestamppb.New(*m.ExpiresAt)
+	}
+	if m.ConsumedAt != nil {
+		to.ConsumedAt = timestamppb.New(*m.ConsumedAt)
+	}
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(AuthorizationCodeWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type AuthorizationCode the arg will be the target, the caller the one being converted from
+
+// AuthorizationCodeBeforeToORM called before default ToORM code
+type AuthorizationCodeWithBeforeToORM interface {
+	BeforeToORM(context.Context, *AuthorizationCodeORM) error
+}
+
+// AuthorizationCodeAfterToORM called after default ToORM code
+type AuthorizationCodeWithAfterToORM interface {
+	AfterToORM(context.Context, *AuthorizationCodeORM) error
+}
+
+// AuthorizationCodeBeforeToPB called before default ToPB code
+type AuthorizationCodeWithBeforeToPB interface {
+	BeforeToPB(context.Context, *AuthorizationCode) error
+}
+
+// AuthorizationCodeAfterToPB called after default ToPB code
+type AuthorizationCodeWithAfterToPB interface {
+	AfterToPB(context.Context, *AuthorizationCode) error
+}
+
+type RevokedTokenORM struct {
+	ExpiresAt *time.Time `gorm:"index:idx_revoked_tokens_expires_at"`
+	Jti       string     `gorm:"primary_key"`
+	RevokedAt *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (RevokedTokenORM) TableName() string {
+	return "revoked_tokens"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *RevokedToken) ToORM(ctx context.Context) (RevokedTokenORM, error) {
+	to := RevokedTokenORM{}
+	var err error
+	if prehook, ok := interface{}(m).(RevokedTokenWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Jti = m.Jti
+	if m.ExpiresAt != nil {
+		t := m.ExpiresAt.AsTime()
+		to.ExpiresAt = &t
+	}
+	if m.RevokedAt != nil {
+		t := m.RevokedAt.AsTime()
+		to.RevokedAt = &t
+	}
+	if posthook, ok := interface{}(m).(RevokedTokenWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *RevokedTokenORM) ToPB(ctx context.Context) (RevokedToken, error) {
+	to := RevokedToken{}
+	var err error
+	if prehook, ok := interface{}(m).(RevokedTokenWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Jti = m.Jti
+	if m.ExpiresAt != nil {
+		to.ExpiresAt = timestamppb.New(*m.ExpiresAt)
+	}
+	if m.RevokedAt != nil {
+		to.RevokedAt = timestamppb.New(*m.RevokedAt)
+	}
+	if posthook, ok := interface{}(m).(RevokedTokenWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type RevokedToken the arg will be the target, the caller the one being converted from
+
+// RevokedTokenBeforeToORM called before default ToORM code
+type RevokedTokenWithBeforeToORM interface {
+	BeforeToORM(context.Context, *RevokedTokenORM) error
+}
+
+// RevokedTokenAfterToORM called after default ToORM code
+type RevokedTokenWithAfterToORM interface {
+	AfterToORM(context.Context, *RevokedTokenORM) error
+}
+
+// RevokedTokenBeforeToPB called before default ToPB code
+type RevokedTokenWithBeforeToPB interface {
+	BeforeToPB(context.Context, *RevokedToken) error
+}
+
+// RevokedTokenAfterToPB called after default ToPB code
+type RevokedTokenWithAfterToPB interface {
+	AfterToPB(context.Context, *RevokedToken) error
+}
+
+type ApiKeyORM struct {
+	CreatedAt  *time.Time
+	ExpiresAt  *time.Time
+	Id         string `gorm:"type:uuid;primary_key"`
+	KeyHash    string `gorm:"unique"`
+	LastUsedAt *time.Time
+	Name       string
+	Prefix     string
+	RevokedAt  *time.Time
+	Scopes     string
+	User       *UserORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	UserId     *string
+}
+
+// TableName overrides the default tablename generated by GORM
+func (ApiKeyORM) TableName() string {
+	return "api_keys"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *ApiKey) ToORM(ctx context.Context) (ApiKeyORM, error) {
+	to := ApiKeyORM{}
+	var err error
+	if prehook, ok := interface{}(m).(ApiKeyWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.Name = m.Name
+	to.Prefix = m.Prefix
+	to.KeyHash = m.KeyHash
+	to.Scopes = m.Scopes
+	if m.ExpiresAt != nil {
+		t := m.ExpiresAt.AsTime()
+		to.ExpiresAt = &t
+	}
+	if m.LastUsedAt != nil {
+		t := m.LastUsedAt.AsTime()
+		to.LastUsedAt = &t
+	}
+	if m.RevokedAt != nil {
+		t := m.RevokedAt.AsTime()
+		to.RevokedAt = &t
+	}
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(ApiKeyWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *ApiKeyORM) ToPB(ctx context.Context) (ApiKey, error) {
+	to := ApiKey{}
+	var err error
+	if prehook, ok := interface{}(m).(ApiKeyWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	to.Name = m.Name
+	to.Prefix = m.Prefix
+	to.KeyHash = m.KeyHash
+	to.Scopes = m.Scopes
+	if m.ExpiresAt != nil {
+		to.ExpiresAt = timestamppb.New(*m.ExpiresAt)
+	}
+	if m.LastUsedAt != nil {
+		to.LastUsedAt = timestamppb.New(*m.LastUsedAt)
+	}
+	if m.RevokedAt != nil {
+		to.RevokedAt = timestamppb.New(*m.RevokedAt)
+	}
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(ApiKeyWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type ApiKey the arg will be the target, the caller the one being converted from
+
+// ApiKeyBeforeToORM called before default ToORM code
+type ApiKeyWithBeforeToORM interface {
+	BeforeToORM(context.Context, *ApiKeyORM) error
+}
+
+// ApiKeyAfterToORM called after default ToORM code
+type ApiKeyWithAfterToORM interface {
+	AfterToORM(context.Context, *ApiKeyORM) error
+}
+
+// ApiKeyBeforeToPB called before default ToPB code
+type ApiKeyWithBeforeToPB interface {
+	BeforeToPB(context.Context, *ApiKey) error
+}
+
+// ApiKeyAfterToPB called after default ToPB code
+type ApiKeyWithAfterToPB interface {
+	AfterToPB(context.Context, *ApiKey) error
+}
+
+// DefaultCreateRefreshToken executes a basic gorm create call
+func DefaultCreateRefreshToken(ctx context.Context, in *RefreshToken, db *gorm.DB) (*RefreshToken, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RefreshTokenORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RefreshTokenORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RefreshTokenORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefreshTokenORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadRefreshToken(ctx context.Context, in *RefreshToken, db *gorm.DB) (*RefreshToken, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RefreshTokenORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &RefreshTokenORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RefreshTokenORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := RefreshTokenORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(RefreshTokenORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RefreshTokenORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefreshTokenORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefreshTokenORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRefreshToken(ctx context.Context, in *RefreshToken, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RefreshTokenORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&RefreshTokenORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(RefreshTokenORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type RefreshTokenORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefreshTokenORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRefreshTokenSet(ctx context.Context, in []*RefreshToken, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&RefreshTokenORM{})).(RefreshTokenORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&RefreshTokenORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&RefreshTokenORM{})).(RefreshTokenORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type RefreshTokenORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*RefreshToken, *gorm.DB) (*gorm.DB, error)
+}
+type RefreshTokenORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*RefreshToken, *gorm.DB) error
+}
+
+// DefaultStrictUpdateRefreshToken clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateRefreshToken(ctx context.Context, in *RefreshToken, db *gorm.DB) (*RefreshToken, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateRefreshToken")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &RefreshTokenORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(RefreshTokenORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(RefreshTokenORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RefreshTokenORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type RefreshTokenORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefreshTokenORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefreshTokenORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchRefreshToken executes a basic gorm update call with patch behavior
+func DefaultPatchRefreshToken(ctx context.Context, in *RefreshToken, updateMask *field_mask.FieldMask, db *gorm.DB) (*RefreshToken, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj RefreshToken
+	var err error
+	if hook, ok := interface{}(&pbObj).(RefreshTokenWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadRefreshToken(ctx, &RefreshToken{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(RefreshTokenWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskRefreshToken(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(RefreshTokenWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateRefreshToken(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(RefreshTokenWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type RefreshTokenWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *RefreshToken, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RefreshTokenWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *RefreshToken, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RefreshTokenWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *RefreshToken, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RefreshTokenWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *RefreshToken, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetRefreshToken executes a bulk gorm update call with patch behavior
+func DefaultPatchSetRefreshToken(ctx context.Context, objects []*RefreshToken, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*RefreshToken, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*RefreshToken, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchRefreshToken(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskRefreshToken patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskRefreshToken(ctx context.Context, patchee *RefreshToken, patcher *RefreshToken, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*RefreshToken, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedUser bool
+	var updatedExpiresAt bool
+	var updatedUsedAt bool
+	var updatedRevokedAt bool
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"TokenHash" {
+			patchee.TokenHash = patcher.TokenHash
+			continue
+		}
+		if f == prefix+"FamilyId" {
+			patchee.FamilyId = patcher.FamilyId
+			continue
+		}
+		if !updatedExpiresAt && strings.HasPrefix(f, prefix+"ExpiresAt.") {
+			if patcher.ExpiresAt == nil {
+				patchee.ExpiresAt = nil
+				continue
+			}
+			if patchee.ExpiresAt == nil {
+				patchee.ExpiresAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ExpiresAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ExpiresAt, patchee.ExpiresAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ExpiresAt" {
+			updatedExpiresAt = true
+			patchee.ExpiresAt = patcher.ExpiresAt
+			continue
+		}
+		if !updatedUsedAt && strings.HasPrefix(f, prefix+"UsedAt.") {
+			if patcher.UsedAt == nil {
+				patchee.UsedAt = nil
+				continue
+			}
+			if patchee.UsedAt == nil {
+				patchee.UsedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UsedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UsedAt, patchee.UsedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UsedAt" {
+			updatedUsedAt = true
+			patchee.UsedAt = patcher.UsedAt
+			continue
+		}
+		if !updatedRevokedAt && strings.HasPrefix(f, prefix+"RevokedAt.") {
+			if patcher.RevokedAt == nil {
+				patchee.RevokedAt = nil
+				continue
+			}
+			if patchee.RevokedAt == nil {
+				patchee.RevokedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"RevokedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.RevokedAt, patchee.RevokedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"RevokedAt" {
+			updatedRevokedAt = true
+			patchee.RevokedAt = patcher.RevokedAt
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListRefreshToken executes a gorm list call
+func DefaultListRefreshToken(ctx context.Context, db *gorm.DB) ([]*RefreshToken, error) {
+	in := RefreshToken{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RefreshTokenORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &RefreshTokenORM{}, &RefreshToken{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RefreshTokenORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []RefreshTokenORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RefreshTokenORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*RefreshToken{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type RefreshTokenORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefreshTokenORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RefreshTokenORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]RefreshTokenORM) error
+}
+
+// DefaultCreateSession executes a basic gorm create call
+func DefaultCreateSession(ctx context.Context, in *Session, db *gorm.DB) (*Session, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SessionORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SessionORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SessionORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SessionORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadSession(ctx context.Context, in *Session, db *gorm.DB) (*Session, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(SessionORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &SessionORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SessionORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := SessionORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(SessionORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type SessionORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SessionORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SessionORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteSession(ctx context.Context, in *Session, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(SessionORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&SessionORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(SessionORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type SessionORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SessionORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteSessionSet(ctx context.Context, in []*Session, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&SessionORM{})).(SessionORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&SessionORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&SessionORM{})).(SessionORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type SessionORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Session, *gorm.DB) (*gorm.DB, error)
+}
+type SessionORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Session, *gorm.DB) error
+}
+
+// DefaultStrictUpdateSession clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateSession(ctx context.Context, in *Session, db *gorm.DB) (*Session, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateSession")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &SessionORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(SessionORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(SessionORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SessionORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type SessionORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SessionORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SessionORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchSession executes a basic gorm update call with patch behavior
+func DefaultPatchSession(ctx context.Context, in *Session, updateMask *field_mask.FieldMask, db *gorm.DB) (*Session, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Session
+	var err error
+	if hook, ok := interface{}(&pbObj).(SessionWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadSession(ctx, &Session{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(SessionWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskSession(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(SessionWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateSession(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(SessionWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type SessionWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Session, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SessionWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Session, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SessionWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Session, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type SessionWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Session, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetSession executes a bulk gorm update call with patch behavior
+func DefaultPatchSetSession(ctx context.Context, objects []*Session, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Session, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Session, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchSession(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskSession patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskSession(ctx context.Context, patchee *Session, patcher *Session, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Session, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedUser bool
+	var updatedCreatedAt bool
+	var updatedLastSeenAt bool
+	var updatedRevokedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"Device" {
+			patchee.Device = patcher.Device
+			continue
+		}
+		if f == prefix+"IpAddress" {
+			patchee.IpAddress = patcher.IpAddress
+			continue
+		}
+		if f == prefix+"UserAgent" {
+			patchee.UserAgent = patcher.UserAgent
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedLastSeenAt && strings.HasPrefix(f, prefix+"LastSeenAt.") {
+			if patcher.LastSeenAt == nil {
+				patchee.LastSeenAt = nil
+				continue
+			}
+			if patchee.LastSeenAt == nil {
+				patchee.LastSeenAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"LastSeenAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.LastSeenAt, patchee.LastSeenAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"LastSeenAt" {
+			updatedLastSeenAt = true
+			patchee.LastSeenAt = patcher.LastSeenAt
+			continue
+		}
+		if !updatedRevokedAt && strings.HasPrefix(f, prefix+"RevokedAt.") {
+			if patcher.RevokedAt == nil {
+				patchee.RevokedAt = nil
+				continue
+			}
+			if patchee.RevokedAt == nil {
+				patchee.RevokedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"RevokedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.RevokedAt, patchee.RevokedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"RevokedAt" {
+			updatedRevokedAt = true
+			patchee.RevokedAt = patcher.RevokedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListSession executes a gorm list call
+func DefaultListSession(ctx context.Context, db *gorm.DB) ([]*Session, error) {
+	in := Session{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SessionORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &SessionORM{}, &Session{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SessionORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []SessionORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(SessionORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Session{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type SessionORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SessionORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type SessionORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]SessionORM) error
+}
+
+// DefaultCreateTotpCredential executes a basic gorm create call
+func DefaultCreateTotpCredential(ctx context.Context, in *TotpCredential, db *gorm.DB) (*TotpCredential, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TotpCredentialORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TotpCredentialORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TotpCredentialORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TotpCredentialORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadTotpCredential(ctx context.Context, in *TotpCredential, db *gorm.DB) (*TotpCredential, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TotpCredentialORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &TotpCredentialORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TotpCredentialORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := TotpCredentialORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(TotpCredentialORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type TotpCredentialORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TotpCredentialORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TotpCredentialORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTotpCredential(ctx context.Context, in *TotpCredential, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(TotpCredentialORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&TotpCredentialORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(TotpCredentialORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type TotpCredentialORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TotpCredentialORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteTotpCredentialSet(ctx context.Context, in []*TotpCredential, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&TotpCredentialORM{})).(TotpCredentialORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&TotpCredentialORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&TotpCredentialORM{})).(TotpCredentialORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type TotpCredentialORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*TotpCredential, *gorm.DB) (*gorm.DB, error)
+}
+type TotpCredentialORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*TotpCredential, *gorm.DB) error
+}
+
+// DefaultStrictUpdateTotpCredential clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateTotpCredential(ctx context.Context, in *TotpCredential, db *gorm.DB) (*TotpCredential, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateTotpCredential")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &TotpCredentialORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(TotpCredentialORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(TotpCredentialORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TotpCredentialORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type TotpCredentialORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TotpCredentialORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TotpCredentialORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchTotpCredential executes a basic gorm update call with patch behavior
+func DefaultPatchTotpCredential(ctx context.Context, in *TotpCredential, updateMask *field_mask.FieldMask, db *gorm.DB) (*TotpCredential, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj TotpCredential
+	var err error
+	if hook, ok := interface{}(&pbObj).(TotpCredentialWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadTotpCredential(ctx, &TotpCredential{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(TotpCredentialWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskTotpCredential(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(TotpCredentialWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateTotpCredential(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(TotpCredentialWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type TotpCredentialWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *TotpCredential, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TotpCredentialWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *TotpCredential, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TotpCredentialWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *TotpCredential, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type TotpCredentialWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *TotpCredential, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetTotpCredential executes a bulk gorm update call with patch behavior
+func DefaultPatchSetTotpCredential(ctx context.Context, objects []*TotpCredential, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*TotpCredential, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*TotpCredential, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchTotpCredential(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskTotpCredential patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskTotpCredential(ctx context.Context, patchee *TotpCredential, patcher *TotpCredential, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*TotpCredential, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedUser bool
+	var updatedConfirmedAt bool
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"EncryptedSecret" {
+			patchee.EncryptedSecret = patcher.EncryptedSecret
+			continue
+		}
+		if f == prefix+"LastUsedStep" {
+			patchee.LastUsedStep = patcher.LastUsedStep
+			continue
+		}
+		if !updatedConfirmedAt && strings.HasPrefix(f, prefix+"ConfirmedAt.") {
+			if patcher.ConfirmedAt == nil {
+				patchee.ConfirmedAt = nil
+				continue
+			}
+			if patchee.ConfirmedAt == nil {
+				patchee.ConfirmedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ConfirmedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ConfirmedAt, patchee.ConfirmedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ConfirmedAt" {
+			updatedConfirmedAt = true
+			patchee.ConfirmedAt = patcher.ConfirmedAt
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListTotpCredential executes a gorm list call
+func DefaultListTotpCredential(ctx context.Context, db *gorm.DB) ([]*TotpCredential, error) {
+	in := TotpCredential{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TotpCredentialORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &TotpCredentialORM{}, &TotpCredential{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TotpCredentialORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []TotpCredentialORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(TotpCredentialORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*TotpCredential{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type TotpCredentialORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TotpCredentialORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type TotpCredentialORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]TotpCredentialORM) error
+}
+
+// DefaultCreateRecoveryCode executes a basic gorm create call
+func DefaultCreateRecoveryCode(ctx context.Context, in *RecoveryCode, db *gorm.DB) (*RecoveryCode, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RecoveryCodeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RecoveryCodeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RecoveryCodeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecoveryCodeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadRecoveryCode(ctx context.Context, in *RecoveryCode, db *gorm.DB) (*RecoveryCode, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RecoveryCodeORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &RecoveryCodeORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RecoveryCodeORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := RecoveryCodeORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(RecoveryCodeORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RecoveryCodeORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecoveryCodeORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecoveryCodeORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRecoveryCode(ctx context.Context, in *RecoveryCode, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RecoveryCodeORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&RecoveryCodeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(RecoveryCodeORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type RecoveryCodeORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecoveryCodeORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRecoveryCodeSet(ctx context.Context, in []*RecoveryCode, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&RecoveryCodeORM{})).(RecoveryCodeORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&RecoveryCodeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&RecoveryCodeORM{})).(RecoveryCodeORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type RecoveryCodeORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*RecoveryCode, *gorm.DB) (*gorm.DB, error)
+}
+type RecoveryCodeORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*RecoveryCode, *gorm.DB) error
+}
+
+// DefaultStrictUpdateRecoveryCode clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateRecoveryCode(ctx context.Context, in *RecoveryCode, db *gorm.DB) (*RecoveryCode, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateRecoveryCode")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &RecoveryCodeORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(RecoveryCodeORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(RecoveryCodeORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RecoveryCodeORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type RecoveryCodeORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecoveryCodeORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecoveryCodeORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchRecoveryCode executes a basic gorm update call with patch behavior
+func DefaultPatchRecoveryCode(ctx context.Context, in *RecoveryCode, updateMask *field_mask.FieldMask, db *gorm.DB) (*RecoveryCode, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj RecoveryCode
+	var err error
+	if hook, ok := interface{}(&pbObj).(RecoveryCodeWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadRecoveryCode(ctx, &RecoveryCode{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(RecoveryCodeWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskRecoveryCode(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(RecoveryCodeWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateRecoveryCode(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(RecoveryCodeWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type RecoveryCodeWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *RecoveryCode, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RecoveryCodeWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *RecoveryCode, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RecoveryCodeWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *RecoveryCode, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RecoveryCodeWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *RecoveryCode, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetRecoveryCode executes a bulk gorm update call with patch behavior
+func DefaultPatchSetRecoveryCode(ctx context.Context, objects []*RecoveryCode, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*RecoveryCode, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*RecoveryCode, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchRecoveryCode(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskRecoveryCode patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskRecoveryCode(ctx context.Context, patchee *RecoveryCode, patcher *RecoveryCode, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*RecoveryCode, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedUser bool
+	var updatedUsedAt bool
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"CodeHash" {
+			patchee.CodeHash = patcher.CodeHash
+			continue
+		}
+		if !updatedUsedAt && strings.HasPrefix(f, prefix+"UsedAt.") {
+			if patcher.UsedAt == nil {
+				patchee.UsedAt = nil
+				continue
+			}
+			if patchee.UsedAt == nil {
+				patchee.UsedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UsedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UsedAt, patchee.UsedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UsedAt" {
+			updatedUsedAt = true
+			patchee.UsedAt = patcher.UsedAt
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListRecoveryCode executes a gorm list call
+func DefaultListRecoveryCode(ctx context.Context, db *gorm.DB) ([]*RecoveryCode, error) {
+	in := RecoveryCode{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RecoveryCodeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &RecoveryCodeORM{}, &RecoveryCode{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RecoveryCodeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []RecoveryCodeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RecoveryCodeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*RecoveryCode{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type RecoveryCodeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecoveryCodeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RecoveryCodeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]RecoveryCodeORM) error
+}
+
+// DefaultCreateOtp executes a basic gorm create call
+func DefaultCreateOtp(ctx context.Context, in *Otp, db *gorm.DB) (*Otp, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OtpORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OtpORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type OtpORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OtpORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadOtp(ctx context.Context, in *Otp, db *gorm.DB) (*Otp, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(OtpORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &OtpORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OtpORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := OtpORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(OtpORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type OtpORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OtpORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OtpORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteOtp(ctx context.Context, in *Otp, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(OtpORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&OtpORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(OtpORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type OtpORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OtpORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteOtpSet(ctx context.Context, in []*Otp, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&OtpORM{})).(OtpORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&OtpORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&OtpORM{})).(OtpORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type OtpORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Otp, *gorm.DB) (*gorm.DB, error)
+}
+type OtpORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Otp, *gorm.DB) error
+}
+
+// DefaultStrictUpdateOtp clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateOtp(ctx context.Context, in *Otp, db *gorm.DB) (*Otp, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateOtp")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &OtpORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(OtpORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(OtpORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OtpORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type OtpORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OtpORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OtpORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchOtp executes a basic gorm update call with patch behavior
+func DefaultPatchOtp(ctx context.Context, in *Otp, updateMask *field_mask.FieldMask, db *gorm.DB) (*Otp, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Otp
+	var err error
+	if hook, ok := interface{}(&pbObj).(OtpWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadOtp(ctx, &Otp{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(OtpWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskOtp(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(OtpWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateOtp(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(OtpWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type OtpWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Otp, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type OtpWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Otp, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type OtpWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Otp, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type OtpWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Otp, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetOtp executes a bulk gorm update call with patch behavior
+func DefaultPatchSetOtp(ctx context.Context, objects []*Otp, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Otp, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Otp, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchOtp(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskOtp patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskOtp(ctx context.Context, patchee *Otp, patcher *Otp, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Otp, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedUser bool
+	var updatedExpiresAt bool
+	var updatedConsumedAt bool
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"Purpose" {
+			patchee.Purpose = patcher.Purpose
+			continue
+		}
+		if f == prefix+"CodeHash" {
+			patchee.CodeHash = patcher.CodeHash
+			continue
+		}
+		if f == prefix+"Attempts" {
+			patchee.Attempts = patcher.Attempts
+			continue
+		}
+		if !updatedExpiresAt && strings.HasPrefix(f, prefix+"ExpiresAt.") {
+			if patcher.ExpiresAt == nil {
+				patchee.ExpiresAt = nil
+				continue
+			}
+			if patchee.ExpiresAt == nil {
+				patchee.ExpiresAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ExpiresAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ExpiresAt, patchee.ExpiresAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ExpiresAt" {
+			updatedExpiresAt = true
+			patchee.ExpiresAt = patcher.ExpiresAt
+			continue
+		}
+		if !updatedConsumedAt && strings.HasPrefix(f, prefix+"ConsumedAt.") {
+			if patcher.ConsumedAt == nil {
+				patchee.ConsumedAt = nil
+				continue
+			}
+			if patchee.ConsumedAt == nil {
+				patchee.ConsumedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ConsumedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ConsumedAt, patchee.ConsumedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ConsumedAt" {
+			updatedConsumedAt = true
+			patchee.ConsumedAt = patcher.ConsumedAt
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListOtp executes a gorm list call
+func DefaultListOtp(ctx context.Context, db *gorm.DB) ([]*Otp, error) {
+	in := Otp{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OtpORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &OtpORM{}, &Otp{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OtpORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []OtpORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(OtpORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Otp{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type OtpORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OtpORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type OtpORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]OtpORM) error
+}
+
+// DefaultCreatePasswordHistory executes a basic gorm create call
+func DefaultCreatePasswordHistory(ctx context.Context, in *PasswordHistory, db *gorm.DB) (*PasswordHistory, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PasswordHistoryORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PasswordHistoryORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PasswordHistoryORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasswordHistoryORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadPasswordHistory(ctx context.Context, in *PasswordHistory, db *gorm.DB) (*PasswordHistory, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PasswordHistoryORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &PasswordHistoryORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PasswordHistoryORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := PasswordHistoryORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(PasswordHistoryORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PasswordHistoryORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasswordHistoryORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasswordHistoryORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePasswordHistory(ctx context.Context, in *PasswordHistory, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PasswordHistoryORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&PasswordHistoryORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(PasswordHistoryORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type PasswordHistoryORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasswordHistoryORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePasswordHistorySet(ctx context.Context, in []*PasswordHistory, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PasswordHistoryORM{})).(PasswordHistoryORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&PasswordHistoryORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&PasswordHistoryORM{})).(PasswordHistoryORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type PasswordHistoryORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*PasswordHistory, *gorm.DB) (*gorm.DB, error)
+}
+type PasswordHistoryORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*PasswordHistory, *gorm.DB) error
+}
+
+// DefaultStrictUpdatePasswordHistory clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePasswordHistory(ctx context.Context, in *PasswordHistory, db *gorm.DB) (*PasswordHistory, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePasswordHistory")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &PasswordHistoryORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(PasswordHistoryORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PasswordHistoryORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PasswordHistoryORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type PasswordHistoryORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasswordHistoryORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasswordHistoryORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchPasswordHistory executes a basic gorm update call with patch behavior
+func DefaultPatchPasswordHistory(ctx context.Context, in *PasswordHistory, updateMask *field_mask.FieldMask, db *gorm.DB) (*PasswordHistory, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj PasswordHistory
+	var err error
+	if hook, ok := interface{}(&pbObj).(PasswordHistoryWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadPasswordHistory(ctx, &PasswordHistory{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(PasswordHistoryWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskPasswordHistory(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(PasswordHistoryWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdatePasswordHistory(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(PasswordHistoryWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type PasswordHistoryWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *PasswordHistory, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PasswordHistoryWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *PasswordHistory, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PasswordHistoryWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *PasswordHistory, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PasswordHistoryWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *PasswordHistory, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetPasswordHistory executes a bulk gorm update call with patch behavior
+func DefaultPatchSetPasswordHistory(ctx context.Context, objects []*PasswordHistory, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PasswordHistory, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*PasswordHistory, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchPasswordHistory(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskPasswordHistory patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPasswordHistory(ctx context.Context, patchee *PasswordHistory, patcher *PasswordHistory, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PasswordHistory, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedUser bool
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"PasswordHash" {
+			patchee.PasswordHash = patcher.PasswordHash
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListPasswordHistory executes a gorm list call
+func DefaultListPasswordHistory(ctx context.Context, db *gorm.DB) ([]*PasswordHistory, error) {
+	in := PasswordHistory{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PasswordHistoryORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PasswordHistoryORM{}, &PasswordHistory{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PasswordHistoryORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []PasswordHistoryORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PasswordHistoryORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*PasswordHistory{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PasswordHistoryORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasswordHistoryORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasswordHistoryORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PasswordHistoryORM) error
+}
+
+// DefaultCreateLoginThrottle executes a basic gorm create call
+func DefaultCreateLoginThrottle(ctx context.Context, in *LoginThrottle, db *gorm.DB) (*LoginThrottle, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LoginThrottleORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LoginThrottleORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type LoginThrottleORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LoginThrottleORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadLoginThrottle(ctx context.Context, in *LoginThrottle, db *gorm.DB) (*LoginThrottle, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Key == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(LoginThrottleORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &LoginThrottleORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LoginThrottleORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := LoginThrottleORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(LoginThrottleORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type LoginThrottleORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LoginThrottleORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LoginThrottleORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteLoginThrottle(ctx context.Context, in *LoginThrottle, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Key == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(LoginThrottleORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&LoginThrottleORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(LoginThrottleORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type LoginThrottleORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LoginThrottleORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteLoginThrottleSet(ctx context.Context, in []*LoginThrottle, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Key == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Key)
+	}
+	if hook, ok := (interface{}(&LoginThrottleORM{})).(LoginThrottleORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("key in (?)", keys).Delete(&LoginThrottleORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&LoginThrottleORM{})).(LoginThrottleORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type LoginThrottleORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*LoginThrottle, *gorm.DB) (*gorm.DB, error)
+}
+type LoginThrottleORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*LoginThrottle, *gorm.DB) error
+}
+
+// DefaultStrictUpdateLoginThrottle clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateLoginThrottle(ctx context.Context, in *LoginThrottle, db *gorm.DB) (*LoginThrottle, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateLoginThrottle")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &LoginThrottleORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("key=?", ormObj.Key).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(LoginThrottleORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(LoginThrottleORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LoginThrottleORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type LoginThrottleORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LoginThrottleORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LoginThrottleORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchLoginThrottle executes a basic gorm update call with patch behavior
+func DefaultPatchLoginThrottle(ctx context.Context, in *LoginThrottle, updateMask *field_mask.FieldMask, db *gorm.DB) (*LoginThrottle, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj LoginThrottle
+	var err error
+	if hook, ok := interface{}(&pbObj).(LoginThrottleWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&pbObj).(LoginThrottleWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskLoginThrottle(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(LoginThrottleWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateLoginThrottle(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(LoginThrottleWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type LoginThrottleWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *LoginThrottle, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LoginThrottleWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *LoginThrottle, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LoginThrottleWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *LoginThrottle, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LoginThrottleWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *LoginThrottle, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetLoginThrottle executes a bulk gorm update call with patch behavior
+func DefaultPatchSetLoginThrottle(ctx context.Context, objects []*LoginThrottle, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*LoginThrottle, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*LoginThrottle, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchLoginThrottle(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskLoginThrottle patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskLoginThrottle(ctx context.Context, patchee *LoginThrottle, patcher *LoginThrottle, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*LoginThrottle, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedLastFailureAt bool
+	var updatedBlockedUntil bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Key" {
+			patchee.Key = patcher.Key
+			continue
+		}
+		if f == prefix+"Failures" {
+			patchee.Failures = patcher.Failures
+			continue
+		}
+		if !updatedLastFailureAt && strings.HasPrefix(f, prefix+"LastFailureAt.") {
+			if patcher.LastFailureAt == nil {
+				patchee.LastFailureAt = nil
+				continue
+			}
+			if patchee.LastFailureAt == nil {
+				patchee.LastFailureAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"LastFailureAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.LastFailureAt, patchee.LastFailureAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"LastFailureAt" {
+			updatedLastFailureAt = true
+			patchee.LastFailureAt = patcher.LastFailureAt
+			continue
+		}
+		if !updatedBlockedUntil && strings.HasPrefix(f, prefix+"BlockedUntil.") {
+			if patcher.BlockedUntil == nil {
+				patchee.BlockedUntil = nil
+				continue
+			}
+			if patchee.BlockedUntil == nil {
+				patchee.BlockedUntil = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"BlockedUntil."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.BlockedUntil, patchee.BlockedUntil, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"BlockedUntil" {
+			updatedBlockedUntil = true
+			patchee.BlockedUntil = patcher.BlockedUntil
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListLoginThrottle executes a gorm list call
+func DefaultListLoginThrottle(ctx context.Context, db *gorm.DB) ([]*LoginThrottle, error) {
+	in := LoginThrottle{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LoginThrottleORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &LoginThrottleORM{}, &LoginThrottle{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LoginThrottleORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("key")
+	ormResponse := []LoginThrottleORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LoginThrottleORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*LoginThrottle{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type LoginThrottleORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LoginThrottleORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LoginThrottleORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]LoginThrottleORM) error
+}
+
+// DefaultCreateLinkedIdentity executes a basic gorm create call
+func DefaultCreateLinkedIdentity(ctx context.Context, in *LinkedIdentity, db *gorm.DB) (*LinkedIdentity, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LinkedIdentityORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LinkedIdentityORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type LinkedIdentityORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LinkedIdentityORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadLinkedIdentity(ctx context.Context, in *LinkedIdentity, db *gorm.DB) (*LinkedIdentity, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(LinkedIdentityORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &LinkedIdentityORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LinkedIdentityORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := LinkedIdentityORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(LinkedIdentityORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type LinkedIdentityORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LinkedIdentityORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LinkedIdentityORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteLinkedIdentity(ctx context.Context, in *LinkedIdentity, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(LinkedIdentityORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&LinkedIdentityORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(LinkedIdentityORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type LinkedIdentityORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LinkedIdentityORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteLinkedIdentitySet(ctx context.Context, in []*LinkedIdentity, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&LinkedIdentityORM{})).(LinkedIdentityORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&LinkedIdentityORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&LinkedIdentityORM{})).(LinkedIdentityORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type LinkedIdentityORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*LinkedIdentity, *gorm.DB) (*gorm.DB, error)
+}
+type LinkedIdentityORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*LinkedIdentity, *gorm.DB) error
+}
+
+// DefaultStrictUpdateLinkedIdentity clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateLinkedIdentity(ctx context.Context, in *LinkedIdentity, db *gorm.DB) (*LinkedIdentity, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateLinkedIdentity")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &LinkedIdentityORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(LinkedIdentityORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(LinkedIdentityORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LinkedIdentityORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type LinkedIdentityORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LinkedIdentityORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LinkedIdentityORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchLinkedIdentity executes a basic gorm update call with patch behavior
+func DefaultPatchLinkedIdentity(ctx context.Context, in *LinkedIdentity, updateMask *field_mask.FieldMask, db *gorm.DB) (*LinkedIdentity, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj LinkedIdentity
+	var err error
+	if hook, ok := interface{}(&pbObj).(LinkedIdentityWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadLinkedIdentity(ctx, &LinkedIdentity{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(LinkedIdentityWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskLinkedIdentity(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(LinkedIdentityWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateLinkedIdentity(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(LinkedIdentityWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type LinkedIdentityWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *LinkedIdentity, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LinkedIdentityWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *LinkedIdentity, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LinkedIdentityWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *LinkedIdentity, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type LinkedIdentityWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *LinkedIdentity, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetLinkedIdentity executes a bulk gorm update call with patch behavior
+func DefaultPatchSetLinkedIdentity(ctx context.Context, objects []*LinkedIdentity, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*LinkedIdentity, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*LinkedIdentity, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchLinkedIdentity(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskLinkedIdentity patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskLinkedIdentity(ctx context.Context, patchee *LinkedIdentity, patcher *LinkedIdentity, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*LinkedIdentity, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedUser bool
+	var updatedLinkedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"Provider" {
+			patchee.Provider = patcher.Provider
+			continue
+		}
+		if f == prefix+"Subject" {
+			patchee.Subject = patcher.Subject
+			continue
+		}
+		if f == prefix+"Email" {
+			patchee.Email = patcher.Email
+			continue
+		}
+		if !updatedLinkedAt && strings.HasPrefix(f, prefix+"LinkedAt.") {
+			if patcher.LinkedAt == nil {
+				patchee.LinkedAt = nil
+				continue
+			}
+			if patchee.LinkedAt == nil {
+				patchee.LinkedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"LinkedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.LinkedAt, patchee.LinkedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"LinkedAt" {
+			updatedLinkedAt = true
+			patchee.LinkedAt = patcher.LinkedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListLinkedIdentity executes a gorm list call
+func DefaultListLinkedIdentity(ctx context.Context, db *gorm.DB) ([]*LinkedIdentity, error) {
+	in := LinkedIdentity{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LinkedIdentityORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &LinkedIdentityORM{}, &LinkedIdentity{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LinkedIdentityORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []LinkedIdentityORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(LinkedIdentityORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*LinkedIdentity{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type LinkedIdentityORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LinkedIdentityORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type LinkedIdentityORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]LinkedIdentityORM) error
+}
+
+// DefaultCreatePasskeyCredential executes a basic gorm create call
+func DefaultCreatePasskeyCredential(ctx context.Context, in *PasskeyCredential, db *gorm.DB) (*PasskeyCredential, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PasskeyCredentialORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PasskeyCredentialORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PasskeyCredentialORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasskeyCredentialORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadPasskeyCredential(ctx context.Context, in *PasskeyCredential, db *gorm.DB) (*PasskeyCredential, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PasskeyCredentialORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &PasskeyCredentialORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PasskeyCredentialORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := PasskeyCredentialORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(PasskeyCredentialORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type PasskeyCredentialORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasskeyCredentialORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasskeyCredentialORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePasskeyCredential(ctx context.Context, in *PasskeyCredential, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(PasskeyCredentialORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&PasskeyCredentialORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(PasskeyCredentialORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type PasskeyCredentialORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasskeyCredentialORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeletePasskeyCredentialSet(ctx context.Context, in []*PasskeyCredential, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&PasskeyCredentialORM{})).(PasskeyCredentialORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&PasskeyCredentialORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&PasskeyCredentialORM{})).(PasskeyCredentialORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type PasskeyCredentialORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*PasskeyCredential, *gorm.DB) (*gorm.DB, error)
+}
+type PasskeyCredentialORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*PasskeyCredential, *gorm.DB) error
+}
+
+// DefaultStrictUpdatePasskeyCredential clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePasskeyCredential(ctx context.Context, in *PasskeyCredential, db *gorm.DB) (*PasskeyCredential, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePasskeyCredential")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &PasskeyCredentialORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(PasskeyCredentialORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(PasskeyCredentialORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PasskeyCredentialORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type PasskeyCredentialORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasskeyCredentialORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasskeyCredentialORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchPasskeyCredential executes a basic gorm update call with patch behavior
+func DefaultPatchPasskeyCredential(ctx context.Context, in *PasskeyCredential, updateMask *field_mask.FieldMask, db *gorm.DB) (*PasskeyCredential, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj PasskeyCredential
+	var err error
+	if hook, ok := interface{}(&pbObj).(PasskeyCredentialWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadPasskeyCredential(ctx, &PasskeyCredential{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(PasskeyCredentialWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskPasskeyCredential(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(PasskeyCredentialWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdatePasskeyCredential(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(PasskeyCredentialWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type PasskeyCredentialWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *PasskeyCredential, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PasskeyCredentialWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *PasskeyCredential, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PasskeyCredentialWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *PasskeyCredential, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type PasskeyCredentialWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *PasskeyCredential, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetPasskeyCredential executes a bulk gorm update call with patch behavior
+func DefaultPatchSetPasskeyCredential(ctx context.Context, objects []*PasskeyCredential, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*PasskeyCredential, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*PasskeyCredential, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchPasskeyCredential(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskPasskeyCredential patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPasskeyCredential(ctx context.Context, patchee *PasskeyCredential, patcher *PasskeyCredential, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*PasskeyCredential, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedUser bool
+	var updatedCreatedAt bool
+	var updatedLastUsedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"CredentialId" {
+			patchee.CredentialId = patcher.CredentialId
+			continue
+		}
+		if f == prefix+"PublicKey" {
+			patchee.PublicKey = patcher.PublicKey
+			continue
+		}
+		if f == prefix+"SignCount" {
+			patchee.SignCount = patcher.SignCount
+			continue
+		}
+		if f == prefix+"Aaguid" {
+			patchee.Aaguid = patcher.Aaguid
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedLastUsedAt && strings.HasPrefix(f, prefix+"LastUsedAt.") {
+			if patcher.LastUsedAt == nil {
+				patchee.LastUsedAt = nil
+				continue
+			}
+			if patchee.LastUsedAt == nil {
+				patchee.LastUsedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"LastUsedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.LastUsedAt, patchee.LastUsedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"LastUsedAt" {
+			updatedLastUsedAt = true
+			patchee.LastUsedAt = patcher.LastUsedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListPasskeyCredential executes a gorm list call
+func DefaultListPasskeyCredential(ctx context.Context, db *gorm.DB) ([]*PasskeyCredential, error) {
+	in := PasskeyCredential{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PasskeyCredentialORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PasskeyCredentialORM{}, &PasskeyCredential{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PasskeyCredentialORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []PasskeyCredentialORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PasskeyCredentialORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*PasskeyCredential{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PasskeyCredentialORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasskeyCredentialORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PasskeyCredentialORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PasskeyCredentialORM) error
+}
+
+// DefaultCreateWebauthnChallenge executes a basic gorm create call
+func DefaultCreateWebauthnChallenge(ctx context.Context, in *WebauthnChallenge, db *gorm.DB) (*WebauthnChallenge, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebauthnChallengeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebauthnChallengeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type WebauthnChallengeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebauthnChallengeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadWebauthnChallenge(ctx context.Context, in *WebauthnChallenge, db *gorm.DB) (*WebauthnChallenge, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(WebauthnChallengeORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &WebauthnChallengeORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebauthnChallengeORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := WebauthnChallengeORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(WebauthnChallengeORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type WebauthnChallengeORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebauthnChallengeORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebauthnChallengeORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteWebauthnChallenge(ctx context.Context, in *WebauthnChallenge, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(WebauthnChallengeORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&WebauthnChallengeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(WebauthnChallengeORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type WebauthnChallengeORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebauthnChallengeORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteWebauthnChallengeSet(ctx context.Context, in []*WebauthnChallenge, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&WebauthnChallengeORM{})).(WebauthnChallengeORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&WebauthnChallengeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&WebauthnChallengeORM{})).(WebauthnChallengeORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type WebauthnChallengeORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*WebauthnChallenge, *gorm.DB) (*gorm.DB, error)
+}
+type WebauthnChallengeORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*WebauthnChallenge, *gorm.DB) error
+}
+
+// DefaultStrictUpdateWebauthnChallenge clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateWebauthnChallenge(ctx context.Context, in *WebauthnChallenge, db *gorm.DB) (*WebauthnChallenge, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateWebauthnChallenge")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &WebauthnChallengeORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(WebauthnChallengeORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(WebauthnChallengeORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebauthnChallengeORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type WebauthnChallengeORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebauthnChallengeORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebauthnChallengeORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchWebauthnChallenge executes a basic gorm update call with patch behavior
+func DefaultPatchWebauthnChallenge(ctx context.Context, in *WebauthnChallenge, updateMask *field_mask.FieldMask, db *gorm.DB) (*WebauthnChallenge, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj WebauthnChallenge
+	var err error
+	if hook, ok := interface{}(&pbObj).(WebauthnChallengeWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadWebauthnChallenge(ctx, &WebauthnChallenge{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(WebauthnChallengeWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskWebauthnChallenge(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(WebauthnChallengeWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateWebauthnChallenge(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(WebauthnChallengeWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type WebauthnChallengeWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *WebauthnChallenge, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type WebauthnChallengeWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *WebauthnChallenge, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type WebauthnChallengeWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *WebauthnChallenge, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type WebauthnChallengeWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *WebauthnChallenge, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetWebauthnChallenge executes a bulk gorm update call with patch behavior
+func DefaultPatchSetWebauthnChallenge(ctx context.Context, objects []*WebauthnChallenge, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*WebauthnChallenge, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*WebauthnChallenge, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchWebauthnChallenge(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskWebauthnChallenge patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskWebauthnChallenge(ctx context.Context, patchee *WebauthnChallenge, patcher *WebauthnChallenge, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*WebauthnChallenge, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedUser bool
+	var updatedExpiresAt bool
+	var updatedConsumedAt bool
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"ChallengeHash" {
+			patchee.ChallengeHash = patcher.ChallengeHash
+			continue
+		}
+		if f == prefix+"Ceremony" {
+			patchee.Ceremony = patcher.Ceremony
+			continue
+		}
+		if !updatedExpiresAt && strings.HasPrefix(f, prefix+"ExpiresAt.") {
+			if patcher.ExpiresAt == nil {
+				patchee.ExpiresAt = nil
+				continue
+			}
+			if patchee.ExpiresAt == nil {
+				patchee.ExpiresAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ExpiresAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ExpiresAt, patchee.ExpiresAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ExpiresAt" {
+			updatedExpiresAt = true
+			patchee.ExpiresAt = patcher.ExpiresAt
+			continue
+		}
+		if !updatedConsumedAt && strings.HasPrefix(f, prefix+"ConsumedAt.") {
+			if patcher.ConsumedAt == nil {
+				patchee.ConsumedAt = nil
+				continue
+			}
+			if patchee.ConsumedAt == nil {
+				patchee.ConsumedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ConsumedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ConsumedAt, patchee.ConsumedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ConsumedAt" {
+			updatedConsumedAt = true
+			patchee.ConsumedAt = patcher.ConsumedAt
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListWebauthnChallenge executes a gorm list call
+func DefaultListWebauthnChallenge(ctx context.Context, db *gorm.DB) ([]*WebauthnChallenge, error) {
+	in := WebauthnChallenge{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebauthnChallengeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &WebauthnChallengeORM{}, &WebauthnChallenge{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebauthnChallengeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []WebauthnChallengeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(WebauthnChallengeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*WebauthnChallenge{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type WebauthnChallengeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebauthnChallengeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type WebauthnChallengeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]WebauthnChallengeORM) error
+}
+
+// DefaultCreateClient executes a basic gorm create call
+func DefaultCreateClient(ctx context.Context, in *Client, db *gorm.DB) (*Client, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ClientORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ClientORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ClientORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClientORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadClient(ctx context.Context, in *Client, db *gorm.DB) (*Client, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ClientORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &ClientORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ClientORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ClientORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ClientORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ClientORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClientORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClientORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteClient(ctx context.Context, in *Client, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ClientORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&ClientORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ClientORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ClientORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClientORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteClientSet(ctx context.Context, in []*Client, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ClientORM{})).(ClientORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ClientORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ClientORM{})).(ClientORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ClientORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Client, *gorm.DB) (*gorm.DB, error)
+}
+type ClientORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Client, *gorm.DB) error
+}
+
+// DefaultStrictUpdateClient clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateClient(ctx context.Context, in *Client, db *gorm.DB) (*Client, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateClient")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &ClientORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(ClientORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ClientORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ClientORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ClientORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClientORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClientORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchClient executes a basic gorm update call with patch behavior
+func DefaultPatchClient(ctx context.Context, in *Client, updateMask *field_mask.FieldMask, db *gorm.DB) (*Client, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Client
+	var err error
+	if hook, ok := interface{}(&pbObj).(ClientWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadClient(ctx, &Client{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ClientWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskClient(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ClientWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateClient(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ClientWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ClientWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Client, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ClientWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Client, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ClientWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Client, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ClientWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Client, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetClient executes a bulk gorm update call with patch behavior
+func DefaultPatchSetClient(ctx context.Context, objects []*Client, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Client, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Client, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchClient(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskClient patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskClient(ctx context.Context, patchee *Client, patcher *Client, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Client, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedSecretRotatedAt bool
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"SecretHash" {
+			patchee.SecretHash = patcher.SecretHash
+			continue
+		}
+		if f == prefix+"Scopes" {
+			patchee.Scopes = patcher.Scopes
+			continue
+		}
+		if f == prefix+"Status" {
+			patchee.Status = patcher.Status
+			continue
+		}
+		if !updatedSecretRotatedAt && strings.HasPrefix(f, prefix+"SecretRotatedAt.") {
+			if patcher.SecretRotatedAt == nil {
+				patchee.SecretRotatedAt = nil
+				continue
+			}
+			if patchee.SecretRotatedAt == nil {
+				patchee.SecretRotatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"SecretRotatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.SecretRotatedAt, patchee.SecretRotatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"SecretRotatedAt" {
+			updatedSecretRotatedAt = true
+			patchee.SecretRotatedAt = patcher.SecretRotatedAt
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+		if f == prefix+"RedirectUris" {
+			patchee.RedirectUris = patcher.RedirectUris
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListClient executes a gorm list call
+func DefaultListClient(ctx context.Context, db *gorm.DB) ([]*Client, error) {
+	in := Client{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ClientORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ClientORM{}, &Client{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ClientORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []ClientORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ClientORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Client{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ClientORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClientORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ClientORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ClientORM) error
+}
+
+// DefaultCreateAuthorizationCode executes a basic gorm create call
+func DefaultCreateAuthorizationCode(ctx context.Context, in *AuthorizationCode, db *gorm.DB) (*AuthorizationCode, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AuthorizationCodeORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AuthorizationCodeORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AuthorizationCodeORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AuthorizationCodeORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadAuthorizationCode(ctx context.Context, in *AuthorizationCode, db *gorm.DB) (*AuthorizationCode, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(AuthorizationCodeORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &AuthorizationCodeORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AuthorizationCodeORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := AuthorizationCodeORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(AuthorizationCodeORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type AuthorizationCodeORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AuthorizationCodeORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AuthorizationCodeORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteAuthorizationCode(ctx context.Context, in *AuthorizationCode, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(AuthorizationCodeORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&AuthorizationCodeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(AuthorizationCodeORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type AuthorizationCodeORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AuthorizationCodeORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteAuthorizationCodeSet(ctx context.Context, in []*AuthorizationCode, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&AuthorizationCodeORM{})).(AuthorizationCodeORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&AuthorizationCodeORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&AuthorizationCodeORM{})).(AuthorizationCodeORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type AuthorizationCodeORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*AuthorizationCode, *gorm.DB) (*gorm.DB, error)
+}
+type AuthorizationCodeORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*AuthorizationCode, *gorm.DB) error
+}
+
+// DefaultStrictUpdateAuthorizationCode clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateAuthorizationCode(ctx context.Context, in *AuthorizationCode, db *gorm.DB) (*AuthorizationCode, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateAuthorizationCode")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &AuthorizationCodeORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(AuthorizationCodeORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(AuthorizationCodeORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AuthorizationCodeORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type AuthorizationCodeORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AuthorizationCodeORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AuthorizationCodeORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchAuthorizationCode executes a basic gorm update call with patch behavior
+func DefaultPatchAuthorizationCode(ctx context.Context, in *AuthorizationCode, updateMask *field_mask.FieldMask, db *gorm.DB) (*AuthorizationCode, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj AuthorizationCode
+	var err error
+	if hook, ok := interface{}(&pbObj).(AuthorizationCodeWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadAuthorizationCode(ctx, &AuthorizationCode{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(AuthorizationCodeWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskAuthorizationCode(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(AuthorizationCodeWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateAuthorizationCode(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(AuthorizationCodeWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type AuthorizationCodeWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *AuthorizationCode, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AuthorizationCodeWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *AuthorizationCode, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AuthorizationCodeWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *AuthorizationCode, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type AuthorizationCodeWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *AuthorizationCode, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetAuthorizationCode executes a bulk gorm update call with patch behavior
+func DefaultPatchSetAuthorizationCode(ctx context.Context, objects []*AuthorizationCode, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*AuthorizationCode, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*AuthorizationCode, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchAuthorizationCode(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskAuthorizationCode patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskAuthorizationCode(ctx context.Context, patchee *AuthorizationCode, patcher *AuthorizationCode, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*AuthorizationCode, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedClient bool
+	var updatedUser bool
+	var updatedAuthTime bool
+	var updatedExpiresAt bool
+	var updatedConsumedAt bool
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedClient && strings.HasPrefix(f, prefix+"Client.") {
+			updatedClient = true
+			if patcher.Client == nil {
+				patchee.Client = nil
+				continue
+			}
+			if patchee.Client == nil {
+				patchee.Client = &Client{}
+			}
+			if o, err := DefaultApplyFieldMaskClient(ctx, patchee.Client, patcher.Client, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Client.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Client = o
+			}
+			continue
+		}
+		if f == prefix+"Client" {
+			updatedClient = true
+			patchee.Client = patcher.Client
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"CodeHash" {
+			patchee.CodeHash = patcher.CodeHash
+			continue
+		}
+		if f == prefix+"RedirectUri" {
+			patchee.RedirectUri = patcher.RedirectUri
+			continue
+		}
+		if f == prefix+"Scope" {
+			patchee.Scope = patcher.Scope
+			continue
+		}
+		if f == prefix+"Nonce" {
+			patchee.Nonce = patcher.Nonce
+			continue
+		}
+		if f == prefix+"CodeChallenge" {
+			patchee.CodeChallenge = patcher.CodeChallenge
+			continue
+		}
+		if !updatedAuthTime && strings.HasPrefix(f, prefix+"AuthTime.") {
+			if patcher.AuthTime == nil {
+				patchee.AuthTime = nil
+				continue
+			}
+			if patchee.AuthTime == nil {
+				patchee.AuthTime = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"AuthTime."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.AuthTime, patchee.AuthTime, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"AuthTime" {
+			updatedAuthTime = true
+			patchee.AuthTime = patcher.AuthTime
+			continue
+		}
+		if !updatedExpiresAt && strings.HasPrefix(f, prefix+"ExpiresAt.") {
+			if patcher.ExpiresAt == nil {
+				patchee.ExpiresAt = nil
+				continue
+			}
+			if patchee.ExpiresAt == nil {
+				patchee.ExpiresAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ExpiresAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ExpiresAt, patchee.ExpiresAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ExpiresAt" {
+			updatedExpiresAt = true
+			patchee.ExpiresAt = patcher.ExpiresAt
+			continue
+		}
+		if !updatedConsumedAt && strings.HasPrefix(f, prefix+"ConsumedAt.") {
+			if patcher.ConsumedAt == nil {
+				patchee.ConsumedAt = nil
+				continue
+			}
+			if patchee.ConsumedAt == nil {
+				patchee.ConsumedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ConsumedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ConsumedAt, patchee.ConsumedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ConsumedAt" {
+			updatedConsumedAt = true
+			patchee.ConsumedAt = patcher.ConsumedAt
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListAuthorizationCode executes a gorm list call
+func DefaultListAuthorizationCode(ctx context.Context, db *gorm.DB) ([]*AuthorizationCode, error) {
+	in := AuthorizationCode{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AuthorizationCodeORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &AuthorizationCodeORM{}, &AuthorizationCode{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AuthorizationCodeORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []AuthorizationCodeORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(AuthorizationCodeORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*AuthorizationCode{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type AuthorizationCodeORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AuthorizationCodeORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type AuthorizationCodeORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]AuthorizationCodeORM) error
+}
+
+// DefaultCreateRevokedToken executes a basic gorm create call
+func DefaultCreateRevokedToken(ctx context.Context, in *RevokedToken, db *gorm.DB) (*RevokedToken, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RevokedTokenORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RevokedTokenORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RevokedTokenORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RevokedTokenORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadRevokedToken(ctx context.Context, in *RevokedToken, db *gorm.DB) (*RevokedToken, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Jti == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RevokedTokenORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &RevokedTokenORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RevokedTokenORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := RevokedTokenORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(RevokedTokenORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RevokedTokenORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RevokedTokenORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RevokedTokenORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRevokedToken(ctx context.Context, in *RevokedToken, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Jti == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RevokedTokenORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&RevokedTokenORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(RevokedTokenORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type RevokedTokenORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RevokedTokenORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRevokedTokenSet(ctx context.Context, in []*RevokedToken, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Jti == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Jti)
+	}
+	if hook, ok := (interface{}(&RevokedTokenORM{})).(RevokedTokenORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("jti in (?)", keys).Delete(&RevokedTokenORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&RevokedTokenORM{})).(RevokedTokenORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type RevokedTokenORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*RevokedToken, *gorm.DB) (*gorm.DB, error)
+}
+type RevokedTokenORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*RevokedToken, *gorm.DB) error
+}
+
+// DefaultStrictUpdateRevokedToken clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateRevokedToken(ctx context.Context, in *RevokedToken, db *gorm.DB) (*RevokedToken, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateRevokedToken")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &RevokedTokenORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("jti=?", ormObj.Jti).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(RevokedTokenORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(RevokedTokenORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RevokedTokenORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type RevokedTokenORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RevokedTokenORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RevokedTokenORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchRevokedToken executes a basic gorm update call with patch behavior
+func DefaultPatchRevokedToken(ctx context.Context, in *RevokedToken, updateMask *field_mask.FieldMask, db *gorm.DB) (*RevokedToken, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj RevokedToken
+	var err error
+	if hook, ok := interface{}(&pbObj).(RevokedTokenWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&pbObj).(RevokedTokenWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskRevokedToken(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(RevokedTokenWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateRevokedToken(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(RevokedTokenWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type RevokedTokenWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *RevokedToken, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RevokedTokenWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *RevokedToken, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RevokedTokenWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *RevokedToken, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RevokedTokenWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *RevokedToken, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetRevokedToken executes a bulk gorm update call with patch behavior
+func DefaultPatchSetRevokedToken(ctx context.Context, objects []*RevokedToken, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*RevokedToken, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*RevokedToken, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchRevokedToken(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskRevokedToken patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskRevokedToken(ctx context.Context, patchee *RevokedToken, patcher *RevokedToken, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*RevokedToken, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedExpiresAt bool
+	var updatedRevokedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Jti" {
+			patchee.Jti = patcher.Jti
+			continue
+		}
+		if !updatedExpiresAt && strings.HasPrefix(f, prefix+"ExpiresAt.") {
+			if patcher.ExpiresAt == nil {
+				patchee.ExpiresAt = nil
+				continue
+			}
+			if patchee.ExpiresAt == nil {
+				patchee.ExpiresAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ExpiresAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ExpiresAt, patchee.ExpiresAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ExpiresAt" {
+			updatedExpiresAt = true
+			patchee.ExpiresAt = patcher.ExpiresAt
+			continue
+		}
+		if !updatedRevokedAt && strings.HasPrefix(f, prefix+"RevokedAt.") {
+			if patcher.RevokedAt == nil {
+				patchee.RevokedAt = nil
+				continue
+			}
+			if patchee.RevokedAt == nil {
+				patchee.RevokedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"RevokedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.RevokedAt, patchee.RevokedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"RevokedAt" {
+			updatedRevokedAt = true
+			patchee.RevokedAt = patcher.RevokedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListRevokedToken executes a gorm list call
+func DefaultListRevokedToken(ctx context.Context, db *gorm.DB) ([]*RevokedToken, error) {
+	in := RevokedToken{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RevokedTokenORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &RevokedTokenORM{}, &RevokedToken{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RevokedTokenORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("jti")
+	ormResponse := []RevokedTokenORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RevokedTokenORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*RevokedToken{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type RevokedTokenORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RevokedTokenORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RevokedTokenORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]RevokedTokenORM) error
+}
+
+// DefaultCreateApiKey executes a basic gorm create call
+func DefaultCreateApiKey(ctx context.Context, in *ApiKey, db *gorm.DB) (*ApiKey, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ApiKeyORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadApiKey(ctx context.Context, in *ApiKey, db *gorm.DB) (*ApiKey, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &ApiKeyORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := ApiKeyORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(ApiKeyORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type ApiKeyORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteApiKey(ctx context.Context, in *ApiKey, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&ApiKeyORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type ApiKeyORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteApiKeySet(ctx context.Context, in []*ApiKey, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&ApiKeyORM{})).(ApiKeyORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&ApiKeyORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&ApiKeyORM{})).(ApiKeyORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type ApiKeyORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*ApiKey, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*ApiKey, *gorm.DB) error
+}
+
+// DefaultStrictUpdateApiKey clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateApiKey(ctx context.Context, in *ApiKey, db *gorm.DB) (*ApiKey, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateApiKey")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &ApiKeyORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type ApiKeyORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchApiKey executes a basic gorm update call with patch behavior
+func DefaultPatchApiKey(ctx context.Context, in *ApiKey, updateMask *field_mask.FieldMask, db *gorm.DB) (*ApiKey, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj ApiKey
+	var err error
+	if hook, ok := interface{}(&pbObj).(ApiKeyWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadApiKey(ctx, &ApiKey{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(ApiKeyWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskApiKey(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(ApiKeyWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateApiKey(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(ApiKeyWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type ApiKeyWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *ApiKey, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *ApiKey, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *ApiKey, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *ApiKey, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetApiKey executes a bulk gorm update call with patch behavior
+func DefaultPatchSetApiKey(ctx context.Context, objects []*ApiKey, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*ApiKey, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*ApiKey, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchApiKey(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskApiKey patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskApiKey(ctx context.Context, patchee *ApiKey, patcher *ApiKey, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*ApiKey, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedUser bool
+	var updatedExpiresAt bool
+	var updatedLastUsedAt bool
+	var updatedRevokedAt bool
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Prefix" {
+			patchee.Prefix = patcher.Prefix
+			continue
+		}
+		if f == prefix+"KeyHash" {
+			patchee.KeyHash = patcher.KeyHash
+			continue
+		}
+		if f == prefix+"Scopes" {
+			patchee.Scopes = patcher.Scopes
+			continue
+		}
+		if !updatedExpiresAt && strings.HasPrefix(f, prefix+"ExpiresAt.") {
+			if patcher.ExpiresAt == nil {
+				patchee.ExpiresAt = nil
+				continue
+			}
+			if patchee.ExpiresAt == nil {
+				patchee.ExpiresAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"ExpiresAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.ExpiresAt, patchee.ExpiresAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"ExpiresAt" {
+			updatedExpiresAt = true
+			patchee.ExpiresAt = patcher.ExpiresAt
+			continue
+		}
+		if !updatedLastUsedAt && strings.HasPrefix(f, prefix+"LastUsedAt.") {
+			if patcher.LastUsedAt == nil {
+				patchee.LastUsedAt = nil
+				continue
+			}
+			if patchee.LastUsedAt == nil {
+				patchee.LastUsedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"LastUsedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.LastUsedAt, patchee.LastUsedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"LastUsedAt" {
+			updatedLastUsedAt = true
+			patchee.LastUsedAt = patcher.LastUsedAt
+			continue
+		}
+		if !updatedRevokedAt && strings.HasPrefix(f, prefix+"RevokedAt.") {
+			if patcher.RevokedAt == nil {
+				patchee.RevokedAt = nil
+				continue
+			}
+			if patchee.RevokedAt == nil {
+				patchee.RevokedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"RevokedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.RevokedAt, patchee.RevokedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"RevokedAt" {
+			updatedRevokedAt = true
+			patchee.RevokedAt = patcher.RevokedAt
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListApiKey executes a gorm list call
+func DefaultListApiKey(ctx context.Context, db *gorm.DB) ([]*ApiKey, error) {
+	in := ApiKey{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &ApiKeyORM{}, &ApiKey{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []ApiKeyORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(ApiKeyORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*ApiKey{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type ApiKeyORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type ApiKeyORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]ApiKeyORM) error
+}
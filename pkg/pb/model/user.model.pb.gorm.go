@@ -13,13 +13,15 @@ import (
 )
 
 type UserPermissionORM struct {
-	CreatedAt  *time.Time
-	Id         int32 `gorm:"type:integer;primary_key"`
-	Permission string
-	Status     int32
-	UpdatedAt  *time.Time
-	User       *UserORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
-	UserId     *string
+	CreatedAt    *time.Time
+	Id           int32 `gorm:"type:integer;primary_key"`
+	Permission   string
+	ResourceId   string
+	ResourceType string
+	Status       int32
+	UpdatedAt    *time.Time
+	User         *UserORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	UserId       *string
 }
 
 // TableName overrides the default tablename generated by GORM
@@ -55,6 +57,8 @@ func (m *UserPermission) ToORM(ctx context.Context) (UserPermissionORM, error) {
 	}
 	to.Status = int32(m.Status)
 	to.Id = m.Id
+	to.ResourceType = m.ResourceType
+	to.ResourceId = m.ResourceId
 	if posthook, ok := interface{}(m).(UserPermissionWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -87,6 +91,8 @@ func (m *UserPermissionORM) ToPB(ctx context.Context) (UserPermission, error) {
 	}
 	to.Status = Status(m.Status)
 	to.Id = m.Id
+	to.ResourceType = m.ResourceType
+	to.ResourceId = m.ResourceId
 	if posthook, ok := interface{}(m).(UserPermissionWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
@@ -116,12 +122,319 @@ type UserPermissionWithAfterToPB interface {
 	AfterToPB(context.Context, *UserPermission) error
 }
 
+type PermissionORM struct {
+	CreatedAt   *time.Time
+	Description string
+	Id          string `gorm:"type:uuid;primary_key"`
+	Name        string `gorm:"unique"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (PermissionORM) TableName() string {
+	return "permissions"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Permission) ToORM(ctx context.Context) (PermissionORM, error) {
+	to := PermissionORM{}
+	var err error
+	if prehook, ok := interface{}(m).(PermissionWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Description = m.Description
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(PermissionWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *PermissionORM) ToPB(ctx context.Context) (Permission, error) {
+	to := Permission{}
+	var err error
+	if prehook, ok := interface{}(m).(PermissionWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Description = m.Description
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if posthook, ok := interface{}(m).(PermissionWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Permission the arg will be the target, the caller the one being converted from
+
+// PermissionBeforeToORM called before default ToORM code
+type PermissionWithBeforeToORM interface {
+	BeforeToORM(context.Context, *PermissionORM) error
+}
+
+// PermissionAfterToORM called after default ToORM code
+type PermissionWithAfterToORM interface {
+	AfterToORM(context.Context, *PermissionORM) error
+}
+
+// PermissionBeforeToPB called before default ToPB code
+type PermissionWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Permission) error
+}
+
+// PermissionAfterToPB called after default ToPB code
+type PermissionWithAfterToPB interface {
+	AfterToPB(context.Context, *Permission) error
+}
+
+type RoleORM struct {
+	CreatedAt   *time.Time
+	Description string
+	Id          string           `gorm:"type:uuid;primary_key"`
+	Name        string           `gorm:"unique"`
+	Permissions []*PermissionORM `gorm:"foreignkey:Id;association_foreignkey:Id;many2many:role_permissions;jointable_foreignkey:RoleId;association_jointable_foreignkey:PermissionId"`
+	System      bool
+	UpdatedAt   *time.Time
+}
+
+// TableName overrides the default tablename generated by GORM
+func (RoleORM) TableName() string {
+	return "roles"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *Role) ToORM(ctx context.Context) (RoleORM, error) {
+	to := RoleORM{}
+	var err error
+	if prehook, ok := interface{}(m).(RoleWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Description = m.Description
+	for _, v := range m.Permissions {
+		if v != nil {
+			if tempPermissions, cErr := v.ToORM(ctx); cErr == nil {
+				to.Permissions = append(to.Permissions, &tempPermissions)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Permissions = append(to.Permissions, nil)
+		}
+	}
+	to.System = m.System
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	if m.UpdatedAt != nil {
+		t := m.UpdatedAt.AsTime()
+		to.UpdatedAt = &t
+	}
+	if posthook, ok := interface{}(m).(RoleWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *RoleORM) ToPB(ctx context.Context) (Role, error) {
+	to := Role{}
+	var err error
+	if prehook, ok := interface{}(m).(RoleWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	to.Name = m.Name
+	to.Description = m.Description
+	for _, v := range m.Permissions {
+		if v != nil {
+			if tempPermissions, cErr := v.ToPB(ctx); cErr == nil {
+				to.Permissions = append(to.Permissions, &tempPermissions)
+			} else {
+				return to, cErr
+			}
+		} else {
+			to.Permissions = append(to.Permissions, nil)
+		}
+	}
+	to.System = m.System
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	if m.UpdatedAt != nil {
+		to.UpdatedAt = timestamppb.New(*m.UpdatedAt)
+	}
+	if posthook, ok := interface{}(m).(RoleWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type Role the arg will be the target, the caller the one being converted from
+
+// RoleBeforeToORM called before default ToORM code
+type RoleWithBeforeToORM interface {
+	BeforeToORM(context.Context, *RoleORM) error
+}
+
+// RoleAfterToORM called after default ToORM code
+type RoleWithAfterToORM interface {
+	AfterToORM(context.Context, *RoleORM) error
+}
+
+// RoleBeforeToPB called before default ToPB code
+type RoleWithBeforeToPB interface {
+	BeforeToPB(context.Context, *Role) error
+}
+
+// RoleAfterToPB called after default ToPB code
+type RoleWithAfterToPB interface {
+	AfterToPB(context.Context, *Role) error
+}
+
+type UserRoleORM struct {
+	CreatedAt    *time.Time
+	Id           string   `gorm:"type:uuid;primary_key"`
+	ResourceId   string   `gorm:"index:idx_user_roles_assignment,unique"`
+	ResourceType string   `gorm:"index:idx_user_roles_assignment,unique"`
+	Role         *RoleORM `gorm:"foreignkey:RoleId;association_foreignkey:Id"`
+	RoleId       *string  `gorm:"index:idx_user_roles_assignment,unique"`
+	User         *UserORM `gorm:"foreignkey:UserId;association_foreignkey:Id"`
+	UserId       *string  `gorm:"index:idx_user_roles_assignment,unique"`
+}
+
+// TableName overrides the default tablename generated by GORM
+func (UserRoleORM) TableName() string {
+	return "user_roles"
+}
+
+// ToORM runs the BeforeToORM hook if present, converts the fields of this
+// object to ORM format, runs the AfterToORM hook, then returns the ORM object
+func (m *UserRole) ToORM(ctx context.Context) (UserRoleORM, error) {
+	to := UserRoleORM{}
+	var err error
+	if prehook, ok := interface{}(m).(UserRoleWithBeforeToORM); ok {
+		if err = prehook.BeforeToORM(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	if m.Role != nil {
+		tempRole, err := m.Role.ToORM(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Role = &tempRole
+	}
+	if m.CreatedAt != nil {
+		t := m.CreatedAt.AsTime()
+		to.CreatedAt = &t
+	}
+	to.ResourceType = m.ResourceType
+	to.ResourceId = m.ResourceId
+	if posthook, ok := interface{}(m).(UserRoleWithAfterToORM); ok {
+		err = posthook.AfterToORM(ctx, &to)
+	}
+	return to, err
+}
+
+// ToPB runs the BeforeToPB hook if present, converts the fields of this
+// object to PB format, runs the AfterToPB hook, then returns the PB object
+func (m *UserRoleORM) ToPB(ctx context.Context) (UserRole, error) {
+	to := UserRole{}
+	var err error
+	if prehook, ok := interface{}(m).(UserRoleWithBeforeToPB); ok {
+		if err = prehook.BeforeToPB(ctx, &to); err != nil {
+			return to, err
+		}
+	}
+	to.Id = m.Id
+	if m.User != nil {
+		tempUser, err := m.User.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.User = &tempUser
+	}
+	if m.Role != nil {
+		tempRole, err := m.Role.ToPB(ctx)
+		if err != nil {
+			return to, err
+		}
+		to.Role = &tempRole
+	}
+	if m.CreatedAt != nil {
+		to.CreatedAt = timestamppb.New(*m.CreatedAt)
+	}
+	to.ResourceType = m.ResourceType
+	to.ResourceId = m.ResourceId
+	if posthook, ok := interface{}(m).(UserRoleWithAfterToPB); ok {
+		err = posthook.AfterToPB(ctx, &to)
+	}
+	return to, err
+}
+
+// The following are interfaces you can implement for special behavior during ORM/PB conversions
+// of type UserRole the arg will be the target, the caller the one being converted from
+
+// UserRoleBeforeToORM called before default ToORM code
+type UserRoleWithBeforeToORM interface {
+	BeforeToORM(context.Context, *UserRoleORM) error
+}
+
+// UserRoleAfterToORM called after default ToORM code
+type UserRoleWithAfterToORM interface {
+	AfterToORM(context.Context, *UserRoleORM) error
+}
+
+// UserRoleBeforeToPB called before default ToPB code
+type UserRoleWithBeforeToPB interface {
+	BeforeToPB(context.Context, *UserRole) error
+}
+
+// UserRoleAfterToPB called after default ToPB code
+type UserRoleWithAfterToPB interface {
+	AfterToPB(context.Context, *UserRole) error
+}
+
 type UserORM struct {
 	Address            *AddressORM `gorm:"foreignkey:AddressId;association_foreignkey:Id"`
 	AddressId          *int32
 	Bio                string
 	CreatedAt          *time.Time
 	Email              string
+	EmailVerified      bool
 	Enable2FA          bool
 	Firstname          string
 	Hosting            bool
@@ -129,6 +442,8 @@ type UserORM struct {
 	ImageUrl           string
 	Lastname           string
 	Password           string
+	PermissionsVersion int64
+	PhoneVerified      bool
 	Role               string
 	Telephone          string
 	Token              string
@@ -181,6 +496,9 @@ func (m *User) ToORM(ctx context.Context) (UserORM, error) {
 	}
 	to.Enable2FA = m.Enable2FA
 	to.Hosting = m.Hosting
+	to.EmailVerified = m.EmailVerified
+	to.PhoneVerified = m.PhoneVerified
+	to.PermissionsVersion = m.PermissionsVersion
 	if posthook, ok := interface{}(m).(UserWithAfterToORM); ok {
 		err = posthook.AfterToORM(ctx, &to)
 	}
@@ -224,6 +542,9 @@ func (m *UserORM) ToPB(ctx context.Context) (User, error) {
 	}
 	to.Enable2FA = m.Enable2FA
 	to.Hosting = m.Hosting
+	to.EmailVerified = m.EmailVerified
+	to.PhoneVerified = m.PhoneVerified
+	to.PermissionsVersion = m.PermissionsVersion
 	if posthook, ok := interface{}(m).(UserWithAfterToPB); ok {
 		err = posthook.AfterToPB(ctx, &to)
 	}
@@ -843,6 +1164,14 @@ func DefaultApplyFieldMaskUserPermission(ctx context.Context, patchee *UserPermi
 			patchee.Id = patcher.Id
 			continue
 		}
+		if f == prefix+"ResourceType" {
+			patchee.ResourceType = patcher.ResourceType
+			continue
+		}
+		if f == prefix+"ResourceId" {
+			patchee.ResourceId = patcher.ResourceId
+			continue
+		}
 	}
 	if err != nil {
 		return nil, err
@@ -903,8 +1232,8 @@ type UserPermissionORMWithAfterListFind interface {
 	AfterListFind(context.Context, *gorm.DB, *[]UserPermissionORM) error
 }
 
-// DefaultCreateUser executes a basic gorm create call
-func DefaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
+// DefaultCreatePermission executes a basic gorm create call
+func DefaultCreatePermission(ctx context.Context, in *Permission, db *gorm.DB) (*Permission, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -912,7 +1241,7 @@ func DefaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(PermissionORMWithBeforeCreate_); ok {
 		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -920,7 +1249,7 @@ func DefaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error
 	if err = db.Create(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithAfterCreate_); ok {
+	if hook, ok := interface{}(&ormObj).(PermissionORMWithAfterCreate_); ok {
 		if err = hook.AfterCreate_(ctx, db); err != nil {
 			return nil, err
 		}
@@ -929,14 +1258,14 @@ func DefaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error
 	return &pbResponse, err
 }
 
-type UserORMWithBeforeCreate_ interface {
+type PermissionORMWithBeforeCreate_ interface {
 	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithAfterCreate_ interface {
+type PermissionORMWithAfterCreate_ interface {
 	AfterCreate_(context.Context, *gorm.DB) error
 }
 
-func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
+func DefaultReadPermission(ctx context.Context, in *Permission, db *gorm.DB) (*Permission, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
@@ -947,24 +1276,24 @@ func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error)
 	if ormObj.Id == "" {
 		return nil, errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadApplyQuery); ok {
+	if hook, ok := interface{}(&ormObj).(PermissionORMWithBeforeReadApplyQuery); ok {
 		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &UserORM{}); err != nil {
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &PermissionORM{}); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadFind); ok {
+	if hook, ok := interface{}(&ormObj).(PermissionORMWithBeforeReadFind); ok {
 		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	ormResponse := UserORM{}
+	ormResponse := PermissionORM{}
 	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormResponse).(UserORMWithAfterReadFind); ok {
+	if hook, ok := interface{}(&ormResponse).(PermissionORMWithAfterReadFind); ok {
 		if err = hook.AfterReadFind(ctx, db); err != nil {
 			return nil, err
 		}
@@ -973,17 +1302,17 @@ func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error)
 	return &pbResponse, err
 }
 
-type UserORMWithBeforeReadApplyQuery interface {
+type PermissionORMWithBeforeReadApplyQuery interface {
 	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithBeforeReadFind interface {
+type PermissionORMWithBeforeReadFind interface {
 	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithAfterReadFind interface {
+type PermissionORMWithAfterReadFind interface {
 	AfterReadFind(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteUser(ctx context.Context, in *User, db *gorm.DB) error {
+func DefaultDeletePermission(ctx context.Context, in *Permission, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -994,29 +1323,29 @@ func DefaultDeleteUser(ctx context.Context, in *User, db *gorm.DB) error {
 	if ormObj.Id == "" {
 		return errors.EmptyIdError
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(PermissionORMWithBeforeDelete_); ok {
 		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where(&ormObj).Delete(&UserORM{}).Error
+	err = db.Where(&ormObj).Delete(&PermissionORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithAfterDelete_); ok {
+	if hook, ok := interface{}(&ormObj).(PermissionORMWithAfterDelete_); ok {
 		err = hook.AfterDelete_(ctx, db)
 	}
 	return err
 }
 
-type UserORMWithBeforeDelete_ interface {
+type PermissionORMWithBeforeDelete_ interface {
 	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithAfterDelete_ interface {
+type PermissionORMWithAfterDelete_ interface {
 	AfterDelete_(context.Context, *gorm.DB) error
 }
 
-func DefaultDeleteUserSet(ctx context.Context, in []*User, db *gorm.DB) error {
+func DefaultDeletePermissionSet(ctx context.Context, in []*Permission, db *gorm.DB) error {
 	if in == nil {
 		return errors.NilArgumentError
 	}
@@ -1032,45 +1361,45 @@ func DefaultDeleteUserSet(ctx context.Context, in []*User, db *gorm.DB) error {
 		}
 		keys = append(keys, ormObj.Id)
 	}
-	if hook, ok := (interface{}(&UserORM{})).(UserORMWithBeforeDeleteSet); ok {
+	if hook, ok := (interface{}(&PermissionORM{})).(PermissionORMWithBeforeDeleteSet); ok {
 		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
 			return err
 		}
 	}
-	err = db.Where("id in (?)", keys).Delete(&UserORM{}).Error
+	err = db.Where("id in (?)", keys).Delete(&PermissionORM{}).Error
 	if err != nil {
 		return err
 	}
-	if hook, ok := (interface{}(&UserORM{})).(UserORMWithAfterDeleteSet); ok {
+	if hook, ok := (interface{}(&PermissionORM{})).(PermissionORMWithAfterDeleteSet); ok {
 		err = hook.AfterDeleteSet(ctx, in, db)
 	}
 	return err
 }
 
-type UserORMWithBeforeDeleteSet interface {
-	BeforeDeleteSet(context.Context, []*User, *gorm.DB) (*gorm.DB, error)
+type PermissionORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Permission, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithAfterDeleteSet interface {
-	AfterDeleteSet(context.Context, []*User, *gorm.DB) error
+type PermissionORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Permission, *gorm.DB) error
 }
 
-// DefaultStrictUpdateUser clears / replaces / appends first level 1:many children and then executes a gorm update call
-func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
+// DefaultStrictUpdatePermission clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdatePermission(ctx context.Context, in *Permission, db *gorm.DB) (*Permission, error) {
 	if in == nil {
-		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateUser")
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdatePermission")
 	}
 	ormObj, err := in.ToORM(ctx)
 	if err != nil {
 		return nil, err
 	}
-	lockedRow := &UserORM{}
+	lockedRow := &PermissionORM{}
 	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateCleanup); ok {
+	if hook, ok := interface{}(&ormObj).(PermissionORMWithBeforeStrictUpdateCleanup); ok {
 		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
 			return nil, err
 		}
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(PermissionORMWithBeforeStrictUpdateSave); ok {
 		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1078,7 +1407,7 @@ func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User,
 	if err = db.Save(&ormObj).Error; err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&ormObj).(UserORMWithAfterStrictUpdateSave); ok {
+	if hook, ok := interface{}(&ormObj).(PermissionORMWithAfterStrictUpdateSave); ok {
 		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
 			return nil, err
 		}
@@ -1090,51 +1419,51 @@ func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User,
 	return &pbResponse, err
 }
 
-type UserORMWithBeforeStrictUpdateCleanup interface {
+type PermissionORMWithBeforeStrictUpdateCleanup interface {
 	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithBeforeStrictUpdateSave interface {
+type PermissionORMWithBeforeStrictUpdateSave interface {
 	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
 }
-type UserORMWithAfterStrictUpdateSave interface {
+type PermissionORMWithAfterStrictUpdateSave interface {
 	AfterStrictUpdateSave(context.Context, *gorm.DB) error
 }
 
-// DefaultPatchUser executes a basic gorm update call with patch behavior
-func DefaultPatchUser(ctx context.Context, in *User, updateMask *field_mask.FieldMask, db *gorm.DB) (*User, error) {
+// DefaultPatchPermission executes a basic gorm update call with patch behavior
+func DefaultPatchPermission(ctx context.Context, in *Permission, updateMask *field_mask.FieldMask, db *gorm.DB) (*Permission, error) {
 	if in == nil {
 		return nil, errors.NilArgumentError
 	}
-	var pbObj User
+	var pbObj Permission
 	var err error
-	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchRead); ok {
+	if hook, ok := interface{}(&pbObj).(PermissionWithBeforePatchRead); ok {
 		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbReadRes, err := DefaultReadUser(ctx, &User{Id: in.GetId()}, db)
+	pbReadRes, err := DefaultReadPermission(ctx, &Permission{Id: in.GetId()}, db)
 	if err != nil {
 		return nil, err
 	}
 	pbObj = *pbReadRes
-	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchApplyFieldMask); ok {
+	if hook, ok := interface{}(&pbObj).(PermissionWithBeforePatchApplyFieldMask); ok {
 		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	if _, err := DefaultApplyFieldMaskUser(ctx, &pbObj, in, updateMask, "", db); err != nil {
+	if _, err := DefaultApplyFieldMaskPermission(ctx, &pbObj, in, updateMask, "", db); err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchSave); ok {
+	if hook, ok := interface{}(&pbObj).(PermissionWithBeforePatchSave); ok {
 		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
 	}
-	pbResponse, err := DefaultStrictUpdateUser(ctx, &pbObj, db)
+	pbResponse, err := DefaultStrictUpdatePermission(ctx, &pbObj, db)
 	if err != nil {
 		return nil, err
 	}
-	if hook, ok := interface{}(pbResponse).(UserWithAfterPatchSave); ok {
+	if hook, ok := interface{}(pbResponse).(PermissionWithAfterPatchSave); ok {
 		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
 			return nil, err
 		}
@@ -1142,28 +1471,28 @@ func DefaultPatchUser(ctx context.Context, in *User, updateMask *field_mask.Fiel
 	return pbResponse, nil
 }
 
-type UserWithBeforePatchRead interface {
-	BeforePatchRead(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type PermissionWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Permission, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type UserWithBeforePatchApplyFieldMask interface {
-	BeforePatchApplyFieldMask(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type PermissionWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Permission, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type UserWithBeforePatchSave interface {
-	BeforePatchSave(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+type PermissionWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Permission, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
 }
-type UserWithAfterPatchSave interface {
-	AfterPatchSave(context.Context, *User, *field_mask.FieldMask, *gorm.DB) error
+type PermissionWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Permission, *field_mask.FieldMask, *gorm.DB) error
 }
 
-// DefaultPatchSetUser executes a bulk gorm update call with patch behavior
-func DefaultPatchSetUser(ctx context.Context, objects []*User, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*User, error) {
+// DefaultPatchSetPermission executes a bulk gorm update call with patch behavior
+func DefaultPatchSetPermission(ctx context.Context, objects []*Permission, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Permission, error) {
 	if len(objects) != len(updateMasks) {
 		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
 	}
 
-	results := make([]*User, 0, len(objects))
+	results := make([]*Permission, 0, len(objects))
 	for i, patcher := range objects {
-		pbResponse, err := DefaultPatchUser(ctx, patcher, updateMasks[i], db)
+		pbResponse, err := DefaultPatchPermission(ctx, patcher, updateMasks[i], db)
 		if err != nil {
 			return nil, err
 		}
@@ -1174,8 +1503,8 @@ func DefaultPatchSetUser(ctx context.Context, objects []*User, updateMasks []*fi
 	return results, nil
 }
 
-// DefaultApplyFieldMaskUser patches an pbObject with patcher according to a field mask.
-func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*User, error) {
+// DefaultApplyFieldMaskPermission patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskPermission(ctx context.Context, patchee *Permission, patcher *Permission, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Permission, error) {
 	if patcher == nil {
 		return nil, nil
 	} else if patchee == nil {
@@ -1183,18 +1512,1226 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 	}
 	var err error
 	var updatedCreatedAt bool
-	var updatedUpdatedAt bool
-	var updatedAddress bool
 	for i, f := range updateMask.Paths {
 		if f == prefix+"Id" {
 			patchee.Id = patcher.Id
 			continue
 		}
-		if f == prefix+"Email" {
-			patchee.Email = patcher.Email
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
 			continue
 		}
-		if f == prefix+"Username" {
+		if f == prefix+"Description" {
+			patchee.Description = patcher.Description
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListPermission executes a gorm list call
+func DefaultListPermission(ctx context.Context, db *gorm.DB) ([]*Permission, error) {
+	in := Permission{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PermissionORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &PermissionORM{}, &Permission{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PermissionORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []PermissionORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(PermissionORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Permission{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type PermissionORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PermissionORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type PermissionORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]PermissionORM) error
+}
+
+// DefaultCreateRole executes a basic gorm create call
+func DefaultCreateRole(ctx context.Context, in *Role, db *gorm.DB) (*Role, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RoleORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RoleORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RoleORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RoleORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadRole(ctx context.Context, in *Role, db *gorm.DB) (*Role, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RoleORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &RoleORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RoleORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := RoleORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(RoleORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type RoleORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RoleORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RoleORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRole(ctx context.Context, in *Role, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(RoleORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&RoleORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(RoleORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type RoleORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RoleORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteRoleSet(ctx context.Context, in []*Role, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&RoleORM{})).(RoleORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&RoleORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&RoleORM{})).(RoleORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type RoleORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*Role, *gorm.DB) (*gorm.DB, error)
+}
+type RoleORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*Role, *gorm.DB) error
+}
+
+// DefaultStrictUpdateRole clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateRole(ctx context.Context, in *Role, db *gorm.DB) (*Role, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateRole")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &RoleORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(RoleORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Model(&ormObj).Association("Permissions").Replace(ormObj.Permissions).Error; err != nil {
+		return nil, err
+	}
+	ormObj.Permissions = nil
+	if hook, ok := interface{}(&ormObj).(RoleORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RoleORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type RoleORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RoleORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RoleORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchRole executes a basic gorm update call with patch behavior
+func DefaultPatchRole(ctx context.Context, in *Role, updateMask *field_mask.FieldMask, db *gorm.DB) (*Role, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj Role
+	var err error
+	if hook, ok := interface{}(&pbObj).(RoleWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadRole(ctx, &Role{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(RoleWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskRole(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(RoleWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateRole(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(RoleWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type RoleWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *Role, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RoleWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *Role, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RoleWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *Role, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type RoleWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *Role, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetRole executes a bulk gorm update call with patch behavior
+func DefaultPatchSetRole(ctx context.Context, objects []*Role, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*Role, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*Role, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchRole(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskRole patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskRole(ctx context.Context, patchee *Role, patcher *Role, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*Role, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Name" {
+			patchee.Name = patcher.Name
+			continue
+		}
+		if f == prefix+"Description" {
+			patchee.Description = patcher.Description
+			continue
+		}
+		if f == prefix+"Permissions" {
+			patchee.Permissions = patcher.Permissions
+			continue
+		}
+		if f == prefix+"System" {
+			patchee.System = patcher.System
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if !updatedUpdatedAt && strings.HasPrefix(f, prefix+"UpdatedAt.") {
+			if patcher.UpdatedAt == nil {
+				patchee.UpdatedAt = nil
+				continue
+			}
+			if patchee.UpdatedAt == nil {
+				patchee.UpdatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"UpdatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.UpdatedAt, patchee.UpdatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"UpdatedAt" {
+			updatedUpdatedAt = true
+			patchee.UpdatedAt = patcher.UpdatedAt
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListRole executes a gorm list call
+func DefaultListRole(ctx context.Context, db *gorm.DB) ([]*Role, error) {
+	in := Role{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RoleORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &RoleORM{}, &Role{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RoleORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []RoleORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(RoleORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*Role{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type RoleORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RoleORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type RoleORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]RoleORM) error
+}
+
+// DefaultCreateUserRole executes a basic gorm create call
+func DefaultCreateUserRole(ctx context.Context, in *UserRole, db *gorm.DB) (*UserRole, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type UserRoleORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserRoleORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadUserRole(ctx context.Context, in *UserRole, db *gorm.DB) (*UserRole, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &UserRoleORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := UserRoleORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(UserRoleORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type UserRoleORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserRoleORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserRoleORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteUserRole(ctx context.Context, in *UserRole, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&UserRoleORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type UserRoleORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserRoleORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteUserRoleSet(ctx context.Context, in []*UserRole, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&UserRoleORM{})).(UserRoleORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&UserRoleORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&UserRoleORM{})).(UserRoleORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type UserRoleORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*UserRole, *gorm.DB) (*gorm.DB, error)
+}
+type UserRoleORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*UserRole, *gorm.DB) error
+}
+
+// DefaultStrictUpdateUserRole clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateUserRole(ctx context.Context, in *UserRole, db *gorm.DB) (*UserRole, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateUserRole")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &UserRoleORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type UserRoleORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserRoleORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserRoleORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchUserRole executes a basic gorm update call with patch behavior
+func DefaultPatchUserRole(ctx context.Context, in *UserRole, updateMask *field_mask.FieldMask, db *gorm.DB) (*UserRole, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj UserRole
+	var err error
+	if hook, ok := interface{}(&pbObj).(UserRoleWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadUserRole(ctx, &UserRole{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(UserRoleWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskUserRole(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(UserRoleWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateUserRole(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(UserRoleWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type UserRoleWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *UserRole, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type UserRoleWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *UserRole, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type UserRoleWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *UserRole, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type UserRoleWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *UserRole, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetUserRole executes a bulk gorm update call with patch behavior
+func DefaultPatchSetUserRole(ctx context.Context, objects []*UserRole, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*UserRole, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*UserRole, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchUserRole(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskUserRole patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskUserRole(ctx context.Context, patchee *UserRole, patcher *UserRole, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*UserRole, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedUser bool
+	var updatedRole bool
+	var updatedCreatedAt bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if !updatedUser && strings.HasPrefix(f, prefix+"User.") {
+			updatedUser = true
+			if patcher.User == nil {
+				patchee.User = nil
+				continue
+			}
+			if patchee.User == nil {
+				patchee.User = &User{}
+			}
+			if o, err := DefaultApplyFieldMaskUser(ctx, patchee.User, patcher.User, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"User.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.User = o
+			}
+			continue
+		}
+		if f == prefix+"User" {
+			updatedUser = true
+			patchee.User = patcher.User
+			continue
+		}
+		if !updatedRole && strings.HasPrefix(f, prefix+"Role.") {
+			updatedRole = true
+			if patcher.Role == nil {
+				patchee.Role = nil
+				continue
+			}
+			if patchee.Role == nil {
+				patchee.Role = &Role{}
+			}
+			if o, err := DefaultApplyFieldMaskRole(ctx, patchee.Role, patcher.Role, &field_mask.FieldMask{Paths: updateMask.Paths[i:]}, prefix+"Role.", db); err != nil {
+				return nil, err
+			} else {
+				patchee.Role = o
+			}
+			continue
+		}
+		if f == prefix+"Role" {
+			updatedRole = true
+			patchee.Role = patcher.Role
+			continue
+		}
+		if !updatedCreatedAt && strings.HasPrefix(f, prefix+"CreatedAt.") {
+			if patcher.CreatedAt == nil {
+				patchee.CreatedAt = nil
+				continue
+			}
+			if patchee.CreatedAt == nil {
+				patchee.CreatedAt = &timestamppb.Timestamp{}
+			}
+			childMask := &field_mask.FieldMask{}
+			for j := i; j < len(updateMask.Paths); j++ {
+				if trimPath := strings.TrimPrefix(updateMask.Paths[j], prefix+"CreatedAt."); trimPath != updateMask.Paths[j] {
+					childMask.Paths = append(childMask.Paths, trimPath)
+				}
+			}
+			if err := gorm1.MergeWithMask(patcher.CreatedAt, patchee.CreatedAt, childMask); err != nil {
+				return nil, nil
+			}
+		}
+		if f == prefix+"CreatedAt" {
+			updatedCreatedAt = true
+			patchee.CreatedAt = patcher.CreatedAt
+			continue
+		}
+		if f == prefix+"ResourceType" {
+			patchee.ResourceType = patcher.ResourceType
+			continue
+		}
+		if f == prefix+"ResourceId" {
+			patchee.ResourceId = patcher.ResourceId
+			continue
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	return patchee, nil
+}
+
+// DefaultListUserRole executes a gorm list call
+func DefaultListUserRole(ctx context.Context, db *gorm.DB) ([]*UserRole, error) {
+	in := UserRole{}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeListApplyQuery); ok {
+		if db, err = hook.BeforeListApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db, err = gorm1.ApplyCollectionOperators(ctx, db, &UserRoleORM{}, &UserRole{}, nil, nil, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithBeforeListFind); ok {
+		if db, err = hook.BeforeListFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	db = db.Where(&ormObj)
+	db = db.Order("id")
+	ormResponse := []UserRoleORM{}
+	if err := db.Find(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserRoleORMWithAfterListFind); ok {
+		if err = hook.AfterListFind(ctx, db, &ormResponse); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse := []*UserRole{}
+	for _, responseEntry := range ormResponse {
+		temp, err := responseEntry.ToPB(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pbResponse = append(pbResponse, &temp)
+	}
+	return pbResponse, nil
+}
+
+type UserRoleORMWithBeforeListApplyQuery interface {
+	BeforeListApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserRoleORMWithBeforeListFind interface {
+	BeforeListFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserRoleORMWithAfterListFind interface {
+	AfterListFind(context.Context, *gorm.DB, *[]UserRoleORM) error
+}
+
+// DefaultCreateUser executes a basic gorm create call
+func DefaultCreateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeCreate_); ok {
+		if db, err = hook.BeforeCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Create(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithAfterCreate_); ok {
+		if err = hook.AfterCreate_(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type UserORMWithBeforeCreate_ interface {
+	BeforeCreate_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithAfterCreate_ interface {
+	AfterCreate_(context.Context, *gorm.DB) error
+}
+
+func DefaultReadUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if ormObj.Id == "" {
+		return nil, errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadApplyQuery); ok {
+		if db, err = hook.BeforeReadApplyQuery(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if db, err = gorm1.ApplyFieldSelection(ctx, db, nil, &UserORM{}); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeReadFind); ok {
+		if db, err = hook.BeforeReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	ormResponse := UserORM{}
+	if err = db.Where(&ormObj).First(&ormResponse).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormResponse).(UserORMWithAfterReadFind); ok {
+		if err = hook.AfterReadFind(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormResponse.ToPB(ctx)
+	return &pbResponse, err
+}
+
+type UserORMWithBeforeReadApplyQuery interface {
+	BeforeReadApplyQuery(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithBeforeReadFind interface {
+	BeforeReadFind(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithAfterReadFind interface {
+	AfterReadFind(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteUser(ctx context.Context, in *User, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return err
+	}
+	if ormObj.Id == "" {
+		return errors.EmptyIdError
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeDelete_); ok {
+		if db, err = hook.BeforeDelete_(ctx, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where(&ormObj).Delete(&UserORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithAfterDelete_); ok {
+		err = hook.AfterDelete_(ctx, db)
+	}
+	return err
+}
+
+type UserORMWithBeforeDelete_ interface {
+	BeforeDelete_(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithAfterDelete_ interface {
+	AfterDelete_(context.Context, *gorm.DB) error
+}
+
+func DefaultDeleteUserSet(ctx context.Context, in []*User, db *gorm.DB) error {
+	if in == nil {
+		return errors.NilArgumentError
+	}
+	var err error
+	keys := []string{}
+	for _, obj := range in {
+		ormObj, err := obj.ToORM(ctx)
+		if err != nil {
+			return err
+		}
+		if ormObj.Id == "" {
+			return errors.EmptyIdError
+		}
+		keys = append(keys, ormObj.Id)
+	}
+	if hook, ok := (interface{}(&UserORM{})).(UserORMWithBeforeDeleteSet); ok {
+		if db, err = hook.BeforeDeleteSet(ctx, in, db); err != nil {
+			return err
+		}
+	}
+	err = db.Where("id in (?)", keys).Delete(&UserORM{}).Error
+	if err != nil {
+		return err
+	}
+	if hook, ok := (interface{}(&UserORM{})).(UserORMWithAfterDeleteSet); ok {
+		err = hook.AfterDeleteSet(ctx, in, db)
+	}
+	return err
+}
+
+type UserORMWithBeforeDeleteSet interface {
+	BeforeDeleteSet(context.Context, []*User, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithAfterDeleteSet interface {
+	AfterDeleteSet(context.Context, []*User, *gorm.DB) error
+}
+
+// DefaultStrictUpdateUser clears / replaces / appends first level 1:many children and then executes a gorm update call
+func DefaultStrictUpdateUser(ctx context.Context, in *User, db *gorm.DB) (*User, error) {
+	if in == nil {
+		return nil, fmt.Errorf("Nil argument to DefaultStrictUpdateUser")
+	}
+	ormObj, err := in.ToORM(ctx)
+	if err != nil {
+		return nil, err
+	}
+	lockedRow := &UserORM{}
+	db.Model(&ormObj).Set("gorm:query_option", "FOR UPDATE").Where("id=?", ormObj.Id).First(lockedRow)
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateCleanup); ok {
+		if db, err = hook.BeforeStrictUpdateCleanup(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithBeforeStrictUpdateSave); ok {
+		if db, err = hook.BeforeStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	if err = db.Save(&ormObj).Error; err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&ormObj).(UserORMWithAfterStrictUpdateSave); ok {
+		if err = hook.AfterStrictUpdateSave(ctx, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := ormObj.ToPB(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &pbResponse, err
+}
+
+type UserORMWithBeforeStrictUpdateCleanup interface {
+	BeforeStrictUpdateCleanup(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithBeforeStrictUpdateSave interface {
+	BeforeStrictUpdateSave(context.Context, *gorm.DB) (*gorm.DB, error)
+}
+type UserORMWithAfterStrictUpdateSave interface {
+	AfterStrictUpdateSave(context.Context, *gorm.DB) error
+}
+
+// DefaultPatchUser executes a basic gorm update call with patch behavior
+func DefaultPatchUser(ctx context.Context, in *User, updateMask *field_mask.FieldMask, db *gorm.DB) (*User, error) {
+	if in == nil {
+		return nil, errors.NilArgumentError
+	}
+	var pbObj User
+	var err error
+	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchRead); ok {
+		if db, err = hook.BeforePatchRead(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbReadRes, err := DefaultReadUser(ctx, &User{Id: in.GetId()}, db)
+	if err != nil {
+		return nil, err
+	}
+	pbObj = *pbReadRes
+	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchApplyFieldMask); ok {
+		if db, err = hook.BeforePatchApplyFieldMask(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	if _, err := DefaultApplyFieldMaskUser(ctx, &pbObj, in, updateMask, "", db); err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(&pbObj).(UserWithBeforePatchSave); ok {
+		if db, err = hook.BeforePatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	pbResponse, err := DefaultStrictUpdateUser(ctx, &pbObj, db)
+	if err != nil {
+		return nil, err
+	}
+	if hook, ok := interface{}(pbResponse).(UserWithAfterPatchSave); ok {
+		if err = hook.AfterPatchSave(ctx, in, updateMask, db); err != nil {
+			return nil, err
+		}
+	}
+	return pbResponse, nil
+}
+
+type UserWithBeforePatchRead interface {
+	BeforePatchRead(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type UserWithBeforePatchApplyFieldMask interface {
+	BeforePatchApplyFieldMask(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type UserWithBeforePatchSave interface {
+	BeforePatchSave(context.Context, *User, *field_mask.FieldMask, *gorm.DB) (*gorm.DB, error)
+}
+type UserWithAfterPatchSave interface {
+	AfterPatchSave(context.Context, *User, *field_mask.FieldMask, *gorm.DB) error
+}
+
+// DefaultPatchSetUser executes a bulk gorm update call with patch behavior
+func DefaultPatchSetUser(ctx context.Context, objects []*User, updateMasks []*field_mask.FieldMask, db *gorm.DB) ([]*User, error) {
+	if len(objects) != len(updateMasks) {
+		return nil, fmt.Errorf(errors.BadRepeatedFieldMaskTpl, len(updateMasks), len(objects))
+	}
+
+	results := make([]*User, 0, len(objects))
+	for i, patcher := range objects {
+		pbResponse, err := DefaultPatchUser(ctx, patcher, updateMasks[i], db)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, pbResponse)
+	}
+
+	return results, nil
+}
+
+// DefaultApplyFieldMaskUser patches an pbObject with patcher according to a field mask.
+func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User, updateMask *field_mask.FieldMask, prefix string, db *gorm.DB) (*User, error) {
+	if patcher == nil {
+		return nil, nil
+	} else if patchee == nil {
+		return nil, errors.NilArgumentError
+	}
+	var err error
+	var updatedCreatedAt bool
+	var updatedUpdatedAt bool
+	var updatedAddress bool
+	for i, f := range updateMask.Paths {
+		if f == prefix+"Id" {
+			patchee.Id = patcher.Id
+			continue
+		}
+		if f == prefix+"Email" {
+			patchee.Email = patcher.Email
+			continue
+		}
+		if f == prefix+"Username" {
 			patchee.Username = patcher.Username
 			continue
 		}
@@ -1309,6 +2846,18 @@ func DefaultApplyFieldMaskUser(ctx context.Context, patchee *User, patcher *User
 			patchee.Hosting = patcher.Hosting
 			continue
 		}
+		if f == prefix+"EmailVerified" {
+			patchee.EmailVerified = patcher.EmailVerified
+			continue
+		}
+		if f == prefix+"PhoneVerified" {
+			patchee.PhoneVerified = patcher.PhoneVerified
+			continue
+		}
+		if f == prefix+"PermissionsVersion" {
+			patchee.PermissionsVersion = patcher.PermissionsVersion
+			continue
+		}
 	}
 	if err != nil {
 		return nil, err
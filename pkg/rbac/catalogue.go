@@ -0,0 +1,112 @@
+// Package rbac loads the permission catalogue and built in roles from the
+// RBAC config file and seeds them into the database
+package rbac
+
+import (
+	_ "embed"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/config"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed default.yaml
+var defaultCatalogue []byte
+
+type PermissionConfig struct {
+	Name        string `yaml:"name"`
+	Description string `yaml:"description"`
+}
+
+type RoleConfig struct {
+	Name        string   `yaml:"name"`
+	Description string   `yaml:"description"`
+	Permissions []string `yaml:"permissions"`
+}
+
+// Catalogue is the contents of the RBAC config file
+type Catalogue struct {
+	// Role given to new users who don't ask for one
+	DefaultRole string             `yaml:"default_role"`
+	Permissions []PermissionConfig `yaml:"permissions"`
+	Roles       []RoleConfig       `yaml:"roles"`
+}
+
+// Load reads the catalogue from RBAC_CONFIG_PATH, or the built in one when
+// it isn't set
+func Load(cfg config.Config) (*Catalogue, error) {
+	data := defaultCatalogue
+	if cfg.RBAC_CONFIG_PATH != "" {
+		var err error
+		data, err = os.ReadFile(cfg.RBAC_CONFIG_PATH)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return Parse(data)
+}
+
+// Parse decodes and validates a catalogue
+func Parse(data []byte) (*Catalogue, error) {
+	var c Catalogue
+	if err := yaml.Unmarshal(data, &c); err != nil {
+		return nil, err
+	}
+
+	permissions := make(map[string]bool)
+	for _, p := range c.Permissions {
+		if err := ValidatePermissionName(p.Name); err != nil {
+			return nil, err
+		}
+		if permissions[p.Name] {
+			return nil, fmt.Errorf("permission %s is listed twice", p.Name)
+		}
+		permissions[p.Name] = true
+	}
+
+	roles := make(map[string]bool)
+	for _, r := range c.Roles {
+		if strings.TrimSpace(r.Name) == "" {
+			return nil, fmt.Errorf("role without a name")
+		}
+		if roles[r.Name] {
+			return nil, fmt.Errorf("role %s is listed twice", r.Name)
+		}
+		roles[r.Name] = true
+		for _, p := range r.Permissions {
+			if !permissions[p] {
+				return nil, fmt.Errorf("role %s has unknown permission %s", r.Name, p)
+			}
+		}
+	}
+
+	if c.DefaultRole != "" && !roles[c.DefaultRole] {
+		return nil, fmt.Errorf("default role %s is not defined", c.DefaultRole)
+	}
+
+	return &c, nil
+}
+
+// ValidatePermissionName checks a permission is made of segments separated
+// by '.' or ':', such as users.read or listing:*:edit
+func ValidatePermissionName(name string) error {
+	if name == "" {
+		return fmt.Errorf("permission without a name")
+	}
+	for _, part := range strings.Split(strings.ReplaceAll(name, ":", "."), ".") {
+		if part == "" {
+			return fmt.Errorf("invalid permission %q", name)
+		}
+		if part == Wildcard {
+			continue
+		}
+		for _, r := range part {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '_' || r == '-') {
+				return fmt.Errorf("invalid permission %q", name)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package rbac
+
+import "strings"
+
+// Wildcard matches any single segment of a permission, or every remaining
+// segment when it ends the pattern
+const Wildcard = "*"
+
+// Grant is a permission held by a user, directly or through a role. A grant
+// without a ResourceType applies everywhere.
+type Grant struct {
+	Permission   string
+	ResourceType string
+	ResourceID   string
+}
+
+// Check is a permission being asked about, optionally on a resource
+type Check struct {
+	Permission   string
+	ResourceType string
+	ResourceID   string
+}
+
+func segments(permission string) []string {
+	return strings.FieldsFunc(permission, func(r rune) bool {
+		return r == '.' || r == ':'
+	})
+}
+
+// Match reports whether a permission pattern such as listing:*:edit covers
+// permission. Segments are separated by '.' or ':'.
+func Match(pattern, permission string) bool {
+	p, s := segments(pattern), segments(permission)
+	for i, part := range p {
+		if part == Wildcard && i == len(p)-1 {
+			return len(s) >= len(p)
+		}
+		if i >= len(s) || (part != Wildcard && part != s[i]) {
+			return false
+		}
+	}
+	return len(p) == len(s)
+}
+
+func matchValue(granted, wanted string) bool {
+	return granted == Wildcard || granted == wanted
+}
+
+// Allows reports whether the grant satisfies the check.
+//
+// A global grant matches the permission itself, or the resource written
+// into it as type:id:permission, so listing:*:edit allows edit on any
+// listing. A scoped grant matches the permission on its resource type, and
+// on every id of that type when its ResourceID is empty or *.
+func (g Grant) Allows(c Check) bool {
+	if g.ResourceType == "" {
+		if Match(g.Permission, c.Permission) {
+			return true
+		}
+		return c.ResourceType != "" &&
+			Match(g.Permission, c.ResourceType+":"+firstOr(c.ResourceID, Wildcard)+":"+c.Permission)
+	}
+
+	if c.ResourceType == "" || !matchValue(g.ResourceType, c.ResourceType) {
+		return false
+	}
+	if g.ResourceID != "" && !matchValue(g.ResourceID, c.ResourceID) {
+		return false
+	}
+	return Match(g.Permission, c.Permission)
+}
+
+// Allowed reports whether any of the grants satisfies the check
+func Allowed(grants []Grant, c Check) bool {
+	for _, g := range grants {
+		if g.Allows(c) {
+			return true
+		}
+	}
+	return false
+}
+
+func firstOr(value, fallback string) string {
+	if value != "" {
+		return value
+	}
+	return fallback
+}
+
+// Name returns the grant as a single permission name. A scoped grant is
+// written as type:id:permission, which Allows treats the same as the
+// scoped grant itself.
+func (g Grant) Name() string {
+	if g.ResourceType == "" {
+		return g.Permission
+	}
+	return g.ResourceType + ":" + firstOr(g.ResourceID, Wildcard) + ":" + g.Permission
+}
@@ -0,0 +1,134 @@
+package rbac
+
+import "testing"
+
+func TestMatch(t *testing.T) {
+	tests := []struct {
+		pattern    string
+		permission string
+		want       bool
+	}{
+		{"users.read", "users.read", true},
+		{"users.read", "users.write", false},
+		{"users.read", "users.read.all", false},
+		{"users.read", "users", false},
+		{"users.read", "Users.read", false},
+
+		{"*", "users.read", true},
+		{"*", "listing:42:edit", true},
+
+		{"users.*", "users.read", true},
+		{"users.*", "users.read.self", true},
+		{"users.*", "users", false},
+		{"users.*", "usersx.read", false},
+		{"users.*", "sessions.read", false},
+
+		{"*.read", "users.read", true},
+		{"*.read", "users.write", false},
+		{"*.read", "users.read.self", false},
+
+		{"listing:*:edit", "listing:42:edit", true},
+		{"listing:*:edit", "listing:42:view", false},
+		{"listing:*:edit", "listing:42:edit:draft", false},
+		{"listing:*:edit", "booking:42:edit", false},
+		{"listing:42:edit", "listing:43:edit", false},
+		{"listing:42:*", "listing:42:view", true},
+
+		// '.' and ':' are interchangeable separators
+		{"listing.*.edit", "listing:42:edit", true},
+		{"users:read", "users.read", true},
+	}
+
+	for _, tt := range tests {
+		if got := Match(tt.pattern, tt.permission); got != tt.want {
+			t.Errorf("Match(%q, %q) = %v, want %v", tt.pattern, tt.permission, got, tt.want)
+		}
+	}
+}
+
+func TestGrantAllows(t *testing.T) {
+	tests := []struct {
+		name  string
+		grant Grant
+		check Check
+		want  bool
+	}{
+		{"global grant", Grant{Permission: "users.read"}, Check{Permission: "users.read"}, true},
+		{"global grant for another permission", Grant{Permission: "users.read"}, Check{Permission: "users.write"}, false},
+		{"global wildcard", Grant{Permission: "*"}, Check{Permission: "users.write"}, true},
+		{"global grant on a resource", Grant{Permission: "edit"},
+			Check{Permission: "edit", ResourceType: "listing", ResourceID: "42"}, true},
+		{"global grant naming any resource", Grant{Permission: "listing:*:edit"},
+			Check{Permission: "edit", ResourceType: "listing", ResourceID: "42"}, true},
+		{"global grant naming one resource", Grant{Permission: "listing:42:edit"},
+			Check{Permission: "edit", ResourceType: "listing", ResourceID: "42"}, true},
+		{"global grant naming another resource", Grant{Permission: "listing:42:edit"},
+			Check{Permission: "edit", ResourceType: "listing", ResourceID: "43"}, false},
+		{"global grant naming one resource, checked on every resource", Grant{Permission: "listing:42:edit"},
+			Check{Permission: "edit", ResourceType: "listing"}, false},
+		{"global grant naming a resource, checked without one", Grant{Permission: "listing:*:edit"},
+			Check{Permission: "edit"}, false},
+
+		{"scoped grant", Grant{Permission: "edit", ResourceType: "listing", ResourceID: "42"},
+			Check{Permission: "edit", ResourceType: "listing", ResourceID: "42"}, true},
+		{"scoped grant on another id", Grant{Permission: "edit", ResourceType: "listing", ResourceID: "42"},
+			Check{Permission: "edit", ResourceType: "listing", ResourceID: "43"}, false},
+		{"scoped grant on another type", Grant{Permission: "edit", ResourceType: "listing", ResourceID: "42"},
+			Check{Permission: "edit", ResourceType: "booking", ResourceID: "42"}, false},
+		{"scoped grant checked without a resource", Grant{Permission: "edit", ResourceType: "listing", ResourceID: "42"},
+			Check{Permission: "edit"}, false},
+		{"scoped grant for another permission", Grant{Permission: "edit", ResourceType: "listing", ResourceID: "42"},
+			Check{Permission: "delete", ResourceType: "listing", ResourceID: "42"}, false},
+		{"scoped grant without an id", Grant{Permission: "edit", ResourceType: "listing"},
+			Check{Permission: "edit", ResourceType: "listing", ResourceID: "43"}, true},
+		{"scoped grant on any id", Grant{Permission: "edit", ResourceType: "listing", ResourceID: "*"},
+			Check{Permission: "edit", ResourceType: "listing", ResourceID: "43"}, true},
+		{"scoped grant on any type", Grant{Permission: "edit", ResourceType: "*"},
+			Check{Permission: "edit", ResourceType: "booking", ResourceID: "7"}, true},
+		{"scoped grant on any type checked without a resource", Grant{Permission: "edit", ResourceType: "*"},
+			Check{Permission: "edit"}, false},
+		{"scoped wildcard permission", Grant{Permission: "*", ResourceType: "listing", ResourceID: "42"},
+			Check{Permission: "delete", ResourceType: "listing", ResourceID: "42"}, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.grant.Allows(tt.check); got != tt.want {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+			// A scoped grant written as a single name must allow the same
+			if tt.grant.ResourceType != "" && tt.grant.ResourceType != Wildcard {
+				named := Grant{Permission: tt.grant.Name()}
+				if got := named.Allows(tt.check); got != tt.want {
+					t.Errorf("as %q: got %v, want %v", named.Permission, got, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAllowed(t *testing.T) {
+	grants := []Grant{
+		{Permission: "users.read"},
+		{Permission: "edit", ResourceType: "listing", ResourceID: "42"},
+	}
+
+	tests := []struct {
+		check Check
+		want  bool
+	}{
+		{Check{Permission: "users.read"}, true},
+		{Check{Permission: "edit", ResourceType: "listing", ResourceID: "42"}, true},
+		{Check{Permission: "edit", ResourceType: "listing", ResourceID: "43"}, false},
+		{Check{Permission: "users.write"}, false},
+	}
+
+	for _, tt := range tests {
+		if got := Allowed(grants, tt.check); got != tt.want {
+			t.Errorf("Allowed(%+v) = %v, want %v", tt.check, got, tt.want)
+		}
+	}
+	if Allowed(nil, Check{Permission: "users.read"}) {
+		t.Error("no grants allowed a check")
+	}
+}
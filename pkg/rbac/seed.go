@@ -0,0 +1,180 @@
+package rbac
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"gorm.io/gorm"
+)
+
+// Seed writes the catalogue to the database. Permissions and system roles
+// are created or brought in line with the file.
+//
+// Data from before roles existed is carried over: permissions already
+// granted directly are added to the catalogue, and users without a role
+// assignment are given the role named in their Role column.
+func Seed(db *gorm.DB, c *Catalogue) error {
+	return db.Transaction(func(tx *gorm.DB) error {
+		now := time.Now()
+
+		permissions := make(map[string]*models.PermissionORM)
+		for _, p := range c.Permissions {
+			permission, err := upsertPermission(tx, p.Name, p.Description, now)
+			if err != nil {
+				return err
+			}
+			permissions[p.Name] = permission
+		}
+
+		var granted []string
+		err := tx.Model(&models.UserPermissionORM{}).
+			Where("status = ?", int32(models.Status_ACTIVE)).
+			Distinct().Pluck("permission", &granted).Error
+		if err != nil {
+			return err
+		}
+		for _, name := range granted {
+			if _, ok := permissions[name]; ok || name == "" {
+				continue
+			}
+			log.Println("Adding directly granted permission to the catalogue:", name)
+			if _, err := upsertPermission(tx, name, "", now); err != nil {
+				return err
+			}
+		}
+
+		for _, r := range c.Roles {
+			var role models.RoleORM
+			err := tx.First(&role, "name = ?", r.Name).Error
+			switch {
+			case errors.Is(err, gorm.ErrRecordNotFound):
+				role = models.RoleORM{
+					Id:          uuid.New().String(),
+					Name:        r.Name,
+					Description: r.Description,
+					System:      true,
+					CreatedAt:   &now,
+					UpdatedAt:   &now,
+				}
+				err = tx.Create(&role).Error
+			case err == nil:
+				err = tx.Model(&role).Updates(map[string]interface{}{
+					"description": r.Description,
+					"system":      true,
+					"updated_at":  now,
+				}).Error
+			}
+			if err != nil {
+				return err
+			}
+
+			var current []string
+			err = tx.Model(&models.PermissionORM{}).
+				Joins("JOIN role_permissions ON role_permissions.permission_orm_id = permissions.id").
+				Where("role_permissions.role_orm_id = ?", role.Id).
+				Pluck("permissions.name", &current).Error
+			if err != nil {
+				return err
+			}
+			if sameNames(current, r.Permissions) {
+				continue
+			}
+
+			rolePermissions := make([]*models.PermissionORM, 0, len(r.Permissions))
+			for _, name := range r.Permissions {
+				rolePermissions = append(rolePermissions, permissions[name])
+			}
+			if err := tx.Model(&role).Association("Permissions").Replace(rolePermissions); err != nil {
+				return err
+			}
+			if err := BumpVersion(tx, RoleHolders(tx, role.Id)); err != nil {
+				return err
+			}
+		}
+
+		return assignLegacyRoles(tx, now)
+	})
+}
+
+func upsertPermission(tx *gorm.DB, name, description string, now time.Time) (*models.PermissionORM, error) {
+	var permission models.PermissionORM
+	err := tx.First(&permission, "name = ?", name).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		permission = models.PermissionORM{
+			Id:          uuid.New().String(),
+			Name:        name,
+			Description: description,
+			CreatedAt:   &now,
+		}
+		return &permission, tx.Create(&permission).Error
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if description != "" && permission.Description != description {
+		permission.Description = description
+		err = tx.Model(&permission).Update("description", description).Error
+	}
+	return &permission, err
+}
+
+// assignLegacyRoles gives users without any role assignment the role in
+// their Role column, creating it without permissions if it isn't defined
+func assignLegacyRoles(tx *gorm.DB, now time.Time) error {
+	var users []models.UserORM
+	err := tx.Select("id", "role").
+		Where("role != '' AND id NOT IN (?)", tx.Model(&models.UserRoleORM{}).Where("user_id IS NOT NULL").Select("user_id")).
+		Find(&users).Error
+	if err != nil {
+		return err
+	}
+
+	roles := make(map[string]string)
+	for _, user := range users {
+		roleID, ok := roles[user.Role]
+		if !ok {
+			var role models.RoleORM
+			err := tx.First(&role, "name = ?", user.Role).Error
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				log.Println("Creating role for existing users:", user.Role)
+				role = models.RoleORM{Id: uuid.New().String(), Name: user.Role, CreatedAt: &now, UpdatedAt: &now}
+				err = tx.Create(&role).Error
+			}
+			if err != nil {
+				return err
+			}
+			roleID = role.Id
+			roles[user.Role] = roleID
+		}
+
+		userID := user.Id
+		assignment := models.UserRoleORM{Id: uuid.New().String(), UserId: &userID, RoleId: &roleID, CreatedAt: &now}
+		if err := tx.Create(&assignment).Error; err != nil {
+			return err
+		}
+		if err := BumpVersion(tx, []string{userID}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func sameNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	seen := make(map[string]bool, len(a))
+	for _, name := range a {
+		seen[name] = true
+	}
+	for _, name := range b {
+		if !seen[name] {
+			return false
+		}
+	}
+	return true
+}
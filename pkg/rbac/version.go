@@ -0,0 +1,22 @@
+package rbac
+
+import (
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"gorm.io/gorm"
+)
+
+// BumpVersion increments the permissions version of the users, marking the
+// permission claims in tokens already issued to them as stale. userIDs is a
+// slice of ids or a subquery selecting them.
+func BumpVersion(tx *gorm.DB, userIDs interface{}) error {
+	return tx.Model(&models.UserORM{}).
+		Where("id IN (?)", userIDs).
+		UpdateColumn("permissions_version", gorm.Expr("permissions_version + 1")).Error
+}
+
+// RoleHolders selects the ids of the users assigned the role
+func RoleHolders(tx *gorm.DB, roleID string) *gorm.DB {
+	return tx.Model(&models.UserRoleORM{}).
+		Where("role_id = ? AND user_id IS NOT NULL", roleID).
+		Select("user_id")
+}
@@ -0,0 +1,178 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lerryjay/auth-grpc-service/pkg/authz"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+// apiKeyPrefix starts every API key so they can be told apart from JWTs and
+// picked up by secret scanners
+const apiKeyPrefix = "ak_"
+
+// apiKeyLastUsedInterval limits how often last use is written for busy keys
+const apiKeyLastUsedInterval = time.Minute
+
+// findAPIKey looks up an active API key whose owner still exists, with the
+// owner loaded. Errors are returned as gRPC statuses.
+func (h *Handler) findAPIKey(key string) (*models.ApiKeyORM, error) {
+	var apiKey models.ApiKeyORM
+	query := h.DB.Preload("User").First(&apiKey, "key_hash = ?", helpers.HashToken(key))
+	if query.Error != nil || apiKey.UserId == nil || apiKey.User == nil {
+		return nil, helpers.TokenStatusError(helpers.ErrTokenClaims)
+	}
+	if apiKey.RevokedAt != nil {
+		return nil, helpers.TokenStatusError(helpers.ErrTokenRevoked)
+	}
+
+	now := time.Now()
+	if apiKey.ExpiresAt != nil && now.After(*apiKey.ExpiresAt) {
+		return nil, helpers.TokenStatusError(helpers.ErrTokenExpired)
+	}
+
+	if apiKey.LastUsedAt == nil || now.Sub(*apiKey.LastUsedAt) > apiKeyLastUsedInterval {
+		query = h.DB.Model(&models.ApiKeyORM{}).Where("id = ?", apiKey.Id).Update("last_used_at", now)
+		if query.Error != nil {
+			log.Println("Error recording API key use", query.Error)
+		}
+	}
+	return &apiKey, nil
+}
+
+// authenticateAPIKey resolves an API key to the user that owns it
+func (h *Handler) authenticateAPIKey(key string) (*authz.Identity, error) {
+	apiKey, err := h.findAPIKey(key)
+	if err != nil {
+		return nil, err
+	}
+	return &authz.Identity{
+		UserID:   *apiKey.UserId,
+		APIKeyID: apiKey.Id,
+		Scopes:   strings.Fields(apiKey.Scopes),
+	}, nil
+}
+
+// validateAPIKey is ValidateToken for API keys
+func (h *Handler) validateAPIKey(key string) (*pb.ValidateTokenResponse, error) {
+	apiKey, err := h.findAPIKey(key)
+	if err != nil {
+		log.Println("Error validating API key", err)
+		return nil, err
+	}
+
+	return &pb.ValidateTokenResponse{
+		Id:       apiKey.User.Id,
+		Role:     apiKey.User.Role,
+		ApiKeyId: apiKey.Id,
+		Scopes:   strings.Fields(apiKey.Scopes),
+	}, nil
+}
+
+// revokeAPIKeys revokes all of the user's API keys. It is called wherever
+// the user's credentials change or they are signed out everywhere, so a key
+// minted by someone else doesn't outlive the account's recovery.
+func revokeAPIKeys(db *gorm.DB, userID string) error {
+	return db.Model(&models.ApiKeyORM{}).
+		Where("user_id = ? AND revoked_at IS NULL", userID).
+		Update("revoked_at", time.Now()).Error
+}
+
+// apiKeyResponse converts an API key for a response, leaving out the hash
+func apiKeyResponse(ctx context.Context, apiKey models.ApiKeyORM) *models.ApiKey {
+	apiKey.KeyHash = ""
+	res, _ := apiKey.ToPB(ctx)
+	return &res
+}
+
+func (h *Handler) CreateAPIKey(ctx context.Context, req *pb.CreateAPIKeyRequest) (*pb.APIKeySecretResponse, error) {
+	var user models.UserORM
+	query := h.DB.First(&user, "id = ?", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "User not found")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "API key name is required")
+	}
+	for _, scope := range req.Scopes {
+		if err := checkGrant(h.DB, scope, "", ""); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	var expiresAt *time.Time
+	if req.ExpiresAt != nil {
+		expiry := req.ExpiresAt.AsTime()
+		if !expiry.After(now) {
+			return nil, status.Errorf(codes.InvalidArgument, "ExpiresAt must be in the future")
+		}
+		expiresAt = &expiry
+	}
+
+	secret, err := helpers.GenerateOpaqueToken(32)
+	if err != nil {
+		log.Println("Error generating API key", err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+	key := apiKeyPrefix + secret
+
+	apiKey := models.ApiKeyORM{
+		Id:        uuid.New().String(),
+		UserId:    &user.Id,
+		Name:      name,
+		Prefix:    key[:len(apiKeyPrefix)+8],
+		KeyHash:   helpers.HashToken(key),
+		Scopes:    strings.Join(req.Scopes, " "),
+		ExpiresAt: expiresAt,
+		CreatedAt: &now,
+	}
+	if err := h.DB.Create(&apiKey).Error; err != nil {
+		log.Println("Error creating API key", err)
+		return nil, status.Errorf(codes.Internal, "Unable to create API key")
+	}
+
+	return &pb.APIKeySecretResponse{ApiKey: apiKeyResponse(ctx, apiKey), Key: key}, nil
+}
+
+func (h *Handler) ListAPIKeys(ctx context.Context, req *pb.ListAPIKeysRequest) (*pb.ListAPIKeysResponse, error) {
+	var apiKeys []models.ApiKeyORM
+	query := h.DB.Order("created_at desc").Find(&apiKeys, "user_id = ? AND revoked_at IS NULL", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.Internal, "Unable to find API keys")
+	}
+
+	res := &pb.ListAPIKeysResponse{}
+	for _, apiKey := range apiKeys {
+		res.ApiKeys = append(res.ApiKeys, apiKeyResponse(ctx, apiKey))
+	}
+	return res, nil
+}
+
+func (h *Handler) RevokeAPIKey(ctx context.Context, req *pb.RevokeAPIKeyRequest) (*emptypb.Empty, error) {
+	query := h.DB.Model(&models.ApiKeyORM{}).
+		Where("id = ? AND user_id = ? AND revoked_at IS NULL", req.Id, req.UserId).
+		Update("revoked_at", time.Now())
+	if query.Error != nil {
+		log.Println("Error revoking API key", query.Error)
+		return nil, status.Errorf(codes.Internal, "Unable to revoke API key")
+	}
+	if query.RowsAffected == 0 {
+		return nil, status.Errorf(codes.NotFound, "API key not found")
+	}
+	return &emptypb.Empty{}, nil
+}
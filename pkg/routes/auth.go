@@ -3,13 +3,17 @@ package routes
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log"
+	"strings"
 	"time"
 
 	"github.com/google/uuid"
 	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
 	"github.com/lerryjay/auth-grpc-service/pkg/pb"
 	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"github.com/lerryjay/auth-grpc-service/pkg/rbac"
+	"github.com/lerryjay/auth-grpc-service/pkg/throttle"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
@@ -28,62 +32,113 @@ func (h *Handler) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequ
 
 	// If the user has a password, validate the old password
 	if user.Password != "" {
-		if valid := helpers.ValidatePasswordHash(user.Password, req.Oldpassword); !valid {
+		if valid := h.Hasher.Verify(user.Password, req.Oldpassword); !valid {
 			return nil, status.Errorf(codes.InvalidArgument, "Old password incorrect")
 		}
 	}
 
+	if err := h.checkPassword("Newpassword", &user, req.Newpassword); err != nil {
+		return nil, err
+	}
+
 	// Hash the new password
-	password, err := helpers.HashPassword(req.Newpassword)
+	password, err := h.Hasher.Hash(req.Newpassword)
 	if err != nil {
 		log.Println(err)
 		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
 	}
 
-	// Update the user's password in the database
-	result := h.DB.Model(&user).Where("id = ?", req.GetId()).Update("Password", password)
-	if result.Error != nil {
+	// Update the user's password in the database, keeping the old one in the
+	// history. The update goes through a fresh model so user.Password still
+	// holds the old hash.
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.UserORM{}).Where("id = ?", user.Id).Update("Password", password).Error; err != nil {
+			return err
+		}
+		if err := h.recordPasswordHistory(tx, user.Id, user.Password); err != nil {
+			return err
+		}
+		return revokeAPIKeys(tx, user.Id)
+	})
+	if err != nil {
+		log.Println(err)
 		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
 	}
 
+	// Sign out everywhere except the session that made the change
+	if err := h.revokeSessions(h.DB, user.Id, "", h.callerSessionID(ctx)); err != nil {
+		log.Println("Error revoking sessions after password change", err)
+	}
+
 	return &emptypb.Empty{}, nil
 }
 
 func (h *Handler) ForgotPassword(ctx context.Context, req *pb.ForgotPasswordRequest) (*pb.ForgotPasswordResponse, error) {
 
+	// Unknown login ids get the same response, so this can't be used to
+	// find out which accounts exist
+	expiresAt := time.Now().Add(h.OTPTTL)
+	res := &pb.ForgotPasswordResponse{
+		TokenExpiryDate: expiresAt.Format(time.RFC3339),
+		Destination:     maskLoginID(req.LoginId),
+	}
+
 	var user models.UserORM
 	query := h.DB.First(&user, "email = ? OR username = ? OR telephone = ?", req.LoginId, req.LoginId, req.LoginId)
+	accountID := user.Id
 	if query.Error != nil {
-		log.Println(query.Error)
-		return nil, status.Errorf(codes.NotFound,
-			"Invalid user")
+		accountID = strings.ToLower(strings.TrimSpace(req.LoginId))
+	}
+	if err := h.checkSendThrottle(ctx, accountID); err != nil {
+		return nil, err
+	}
+	if query.Error != nil {
+		log.Println("Password reset requested for unknown login id", query.Error)
+		return res, nil
 	}
 
-	// Send email to send token if user is found
-	user.Token = helpers.GetOTP(6, true)
-	now := time.Now()
-	user.UpdatedAt = &now
+	code, _, err := h.issueOTP(h.DB, user.Id, models.OtpPurpose_PASSWORD_RESET)
+	if err != nil {
+		log.Println("Error creating password reset code", err)
+		return nil, status.Errorf(codes.Internal,
+			"Unable to send password reset code")
+	}
 
-	h.DB.Save(user)
+	// Deliver the code out of band so only the owner of the account sees it
+	email, telephone := loginAddress(&user, req.LoginId)
+	_, err = h.Notifier.SendToUser(ctx, email, telephone, "password_reset", map[string]interface{}{
+		"FirstName":        user.Firstname,
+		"Code":             code,
+		"ExpiresInMinutes": int(h.OTPTTL.Minutes()),
+	})
+	if err != nil {
+		log.Println("Error sending password reset code", err)
+		return nil, status.Errorf(codes.Internal,
+			"Unable to send password reset code")
+	}
 
-	return &pb.ForgotPasswordResponse{
-		Token:     user.Token,
-		FirstName: user.Firstname,
-		LastName:  user.Lastname,
-		Email:     user.Email,
-	}, nil
+	return res, nil
 }
 
 func (h *Handler) ValidateToken(ctx context.Context, req *pb.ValidateTokenRequest) (*pb.ValidateTokenResponse, error) {
-	payload, error := helpers.ValidateJWTToken(req.Token)
-	if payload == "" || error != nil {
-		log.Println("Error validating token ", req.Token)
-		return nil, status.Errorf(codes.Unauthenticated,
-			"Invalid authentication token or expired")
+	if strings.HasPrefix(req.Token, apiKeyPrefix) {
+		return h.validateAPIKey(req.Token)
+	}
+
+	claims, err := h.authenticate(req.Token)
+	if err != nil {
+		log.Println("Error validating token", err)
+		return nil, err
 	}
 	data := pb.ValidateTokenResponse{}
 
-	json.Unmarshal([]byte(payload), &data)
+	json.Unmarshal([]byte(claims.User), &data)
+	data.SessionId = claims.SessionId
+
+	if err := h.checkPermissionClaims(claims, &data); err != nil {
+		log.Println("Error validating token", err)
+		return nil, helpers.TokenStatusError(err)
+	}
 
 	return &data, nil
 }
@@ -98,25 +153,36 @@ func (h *Handler) VerifyOTP(ctx context.Context, req *pb.VerifyOTPRequest) (*emp
 			"User not found")
 	}
 
-	now := time.Now()
-	expiredTime := user.UpdatedAt.Add(10 * time.Minute)
-	expired := now.After(expiredTime)
+	accountKey := throttle.AccountKey(throttleOTP, user.Id)
+	keys := throttleKeys(ctx, throttleOTP, accountKey)
+	if err := h.checkThrottle(ctx, keys...); err != nil {
+		return nil, err
+	}
 
-	if user.Token != req.Token || expired {
-		log.Println("Invalid OTP", query.Error)
-		return nil, status.Errorf(codes.PermissionDenied,
-			"Invalid or expired authentication token")
+	if err := h.checkOTP(h.DB, user.Id, req.Purpose, req.Token, false); err != nil {
+		log.Println("Invalid OTP", err)
+		if isOTPFailure(err) {
+			h.recordFailure(ctx, keys...)
+		}
+		return nil, otpError(err)
 	}
+	h.clearFailures(ctx, accountKey)
 
 	return &emptypb.Empty{}, nil
 }
 
+// HasPermission checks the user holds the permission, on the resource if
+// one is given, directly or through one of their roles
 func (h *Handler) HasPermission(ctx context.Context, req *pb.HasPermissionRequest) (*emptypb.Empty, error) {
 
-	var userPermission models.UserPermissionORM
-	query := h.DB.First(&userPermission, "permission = ? AND user_id = ? AND status = ?", req.Permission, req.Id, int32(models.Status_ACTIVE))
-	if query.Error != nil {
-		log.Println(query.Error)
+	grants, err := userGrants(h.DB, req.Id, true)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	check := rbac.Check{Permission: req.Permission, ResourceType: req.ResourceType, ResourceID: req.ResourceId}
+	if !rbac.Allowed(grants, check) {
 		return nil, status.Errorf(codes.PermissionDenied,
 			codes.PermissionDenied.String())
 	}
@@ -124,77 +190,137 @@ func (h *Handler) HasPermission(ctx context.Context, req *pb.HasPermissionReques
 	return &emptypb.Empty{}, nil
 }
 
+// CheckPermissions answers several HasPermission questions for one user
+func (h *Handler) CheckPermissions(ctx context.Context, req *pb.CheckPermissionsRequest) (*pb.CheckPermissionsResponse, error) {
+
+	grants, err := userGrants(h.DB, req.UserId, true)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	res := &pb.CheckPermissionsResponse{}
+	for _, c := range req.Checks {
+		check := rbac.Check{Permission: c.Permission, ResourceType: c.ResourceType, ResourceID: c.ResourceId}
+		res.Results = append(res.Results, &pb.PermissionCheckResult{
+			Check:   c,
+			Allowed: c.Permission != "" && rbac.Allowed(grants, check),
+		})
+	}
+
+	return res, nil
+}
+
 func (h *Handler) SocialLogin(ctx context.Context, req *pb.SocialLoginRequest) (*pb.LoginUserResponse, error) {
 
-	var user models.UserORM
-	query := h.DB.First(&user, "email = ? ", req.Email)
-	if query.Error != nil {
-		user = models.UserORM{
-			Id:        uuid.New().String(),
-			Email:     req.Email,
-			Firstname: req.FirstName,
-			Lastname:  req.LastName,
-			ImageUrl:  req.Imageurl,
-			Role:      "USER",
-		}
+	// Only the provider's signed claims are trusted to say who the user is
+	claims, err := h.Identity.Verify(ctx, req.Issuer, req.Token)
+	if err != nil {
+		log.Println("Error verifying social login token", err)
+		return nil, identityError(err)
+	}
 
-		query = h.DB.Create(&user)
-		if query.Error != nil {
-			log.Println(query.Error)
-			return nil, status.Errorf(codes.Internal,
-				"Unable to create user. DB failed to insert")
-		}
+	// Accounts that already signed in with this provider are found by their
+	// id at the provider, so changing the email there doesn't lose the account
+	linked, err := h.findLinkedUser(claims)
+	if err == nil {
+		return h.completeLogin(ctx, linked)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
 	}
 
-	claims := map[string]string{
-		"Id":   user.Id,
-		"Role": user.Role,
+	var user models.UserORM
+	// Verified claims are lowercased, stored emails may not be
+	query := h.DB.First(&user, "LOWER(email) = ?", claims.Email)
+	if query.Error == nil && !user.EmailVerified && user.Password != "" {
+		// Anyone can register a password account with someone else's
+		// address, so only sign in to it once the owner has proven the email
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Verify your email address before signing in with a social account")
 	}
 
-	tokenString, err := helpers.GenerateToken(claims)
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		if query.Error == nil {
+			if !user.EmailVerified {
+				if err := tx.Model(&user).Update("EmailVerified", true).Error; err != nil {
+					return err
+				}
+			}
+		} else {
+			// Apple only shares the user's name with the app, not in the
+			// token, so profile details fall back to the request
+			user = models.UserORM{
+				Id:            uuid.New().String(),
+				Email:         claims.Email,
+				Firstname:     firstNonEmpty(claims.FirstName, req.FirstName),
+				Lastname:      firstNonEmpty(claims.LastName, req.LastName),
+				ImageUrl:      firstNonEmpty(claims.Picture, req.Imageurl),
+				Role:          h.DefaultRole,
+				EmailVerified: true,
+			}
+			if err := tx.Create(&user).Error; err != nil {
+				return err
+			}
+			if err := assignNamedRole(tx, user.Id, h.DefaultRole); err != nil {
+				return err
+			}
+		}
+		return tx.Create(newLinkedIdentity(user.Id, claims)).Error
+	})
 	if err != nil {
-		log.Println(query.Error)
+		log.Println(err)
 		return nil, status.Errorf(codes.Internal,
-			"Error authenticating user!")
+			"Unable to create user. DB failed to insert")
 	}
 
-	return &pb.LoginUserResponse{
-		Token:   tokenString,
-		Message: "Login Successful",
-	}, nil
+	return h.completeLogin(ctx, &user)
 }
 
 func (h *Handler) LoginUser(ctx context.Context, req *pb.LoginUserRequest) (*pb.LoginUserResponse, error) {
 
 	var user models.UserORM
 	query := h.DB.First(&user, "email = ? OR username = ? OR telephone = ?", req.LoginId, req.LoginId, req.LoginId)
+	accountKey := loginThrottleKey(&user, req.LoginId)
+	keys := throttleKeys(ctx, throttleLogin, accountKey)
+	if err := h.checkThrottle(ctx, keys...); err != nil {
+		return nil, err
+	}
+
 	if query.Error != nil {
 		log.Println(query.Error)
+		h.recordFailure(ctx, keys...)
 		return nil, status.Errorf(codes.InvalidArgument,
 			"Invalid username or password")
 	}
 
-	if valid := helpers.ValidatePasswordHash(user.Password, *req.Password); !valid {
+	// Accounts created without a password can't sign in with an empty one
+	if user.Password == "" || req.GetPassword() == "" || !h.Hasher.Verify(user.Password, req.GetPassword()) {
+		h.recordFailure(ctx, keys...)
 		return nil, status.Errorf(codes.InvalidArgument,
 			"Invalid username or password")
 	}
 
-	claims := map[string]string{
-		"Id":   user.Id,
-		"Role": user.Role,
+	// Only the account is cleared, an address trying many accounts stays throttled
+	h.clearFailures(ctx, accountKey)
+
+	if !h.loginVerified(&user) {
+		return nil, notVerifiedError(&user)
 	}
 
-	tokenString, err := helpers.GenerateToken(claims)
-	if err != nil {
-		log.Println(query.Error)
-		return nil, status.Errorf(codes.Internal,
-			"Error authenticating user!")
+	// Move the user onto the current hashing policy while we have the password
+	if h.Hasher.NeedsRehash(user.Password) {
+		if hash, err := h.Hasher.Hash(req.GetPassword()); err != nil {
+			log.Println("Error rehashing password", err)
+		} else if err := h.DB.Model(&models.UserORM{}).Where("id = ? AND password = ?", user.Id, user.Password).Update("Password", hash).Error; err != nil {
+			log.Println("Error saving rehashed password", err)
+		} else {
+			user.Password = hash
+		}
 	}
 
-	return &pb.LoginUserResponse{
-		Token:   tokenString,
-		Message: "Login Successful",
-	}, nil
+	return h.completeLogin(ctx, &user)
 }
 
 func (h *Handler) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*emptypb.Empty, error) {
@@ -207,45 +333,81 @@ func (h *Handler) ResetPassword(ctx context.Context, req *pb.ResetPasswordReques
 			"User not found")
 	}
 
-	_, err := h.VerifyOTP(ctx, &pb.VerifyOTPRequest{
-		LoginId: req.LoginId,
-		Token:   req.Token,
-	})
+	// Reset codes can be guessed here as well as through VerifyOTP
+	accountKey := throttle.AccountKey(throttleOTP, user.Id)
+	keys := throttleKeys(ctx, throttleOTP, accountKey)
+	if err := h.checkThrottle(ctx, keys...); err != nil {
+		return nil, err
+	}
+
+	if req.Password != req.PasswordConfirmation {
+		return nil, status.Errorf(codes.InvalidArgument,
+			"Password confirmation does not match")
+	}
+
+	// The code is checked before any password hashing, so callers without
+	// one can't make us do that work
+	if err := h.checkOTP(h.DB, user.Id, models.OtpPurpose_PASSWORD_RESET, req.Token, false); err != nil {
+		log.Println("Error resetting password", err)
+		if isOTPFailure(err) {
+			h.recordFailure(ctx, keys...)
+		}
+		return nil, otpError(err)
+	}
+
+	if err := h.checkPassword("Password", &user, req.Password); err != nil {
+		return nil, err
+	}
 
-	hashPassword, err := helpers.HashPassword(req.Password)
+	hashPassword, err := h.Hasher.Hash(req.Password)
 	if err != nil {
 		log.Println("Could not generate new user password hash", err)
 		return nil, status.Errorf(codes.Internal,
 			"Could not generate new user password hash")
 	}
 
-	user.Password = hashPassword
-	user.Token = helpers.GetOTP(6, true)
-	h.DB.Save(user)
+	// The code is consumed in the same transaction as the password change so
+	// it can only ever be used for one reset
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := h.checkOTP(tx, user.Id, models.OtpPurpose_PASSWORD_RESET, req.Token, true); err != nil {
+			return err
+		}
+		if err := tx.Model(&models.UserORM{}).Where("id = ?", user.Id).Update("Password", hashPassword).Error; err != nil {
+			return err
+		}
+		if err := h.recordPasswordHistory(tx, user.Id, user.Password); err != nil {
+			return err
+		}
+		if err := revokeAPIKeys(tx, user.Id); err != nil {
+			return err
+		}
+		return h.revokeSessions(tx, user.Id, "", "")
+	})
+	if err != nil {
+		log.Println("Error resetting password", err)
+		if isOTPFailure(err) {
+			h.recordFailure(ctx, keys...)
+		}
+		return nil, otpError(err)
+	}
+	h.clearFailures(ctx, accountKey)
+
+	_, err = h.Notifier.SendToUser(ctx, user.Email, user.Telephone, "password_changed", map[string]interface{}{
+		"FirstName": user.Firstname,
+	})
+	if err != nil {
+		log.Println("Error sending password changed notification", err)
+	}
 
 	return &emptypb.Empty{}, nil
 }
 
 func (h *Handler) ListUserPermissions(ctx context.Context, req *pb.ListUserPermissionRequest) (*pb.ListUserPermissionsResponse, error) {
 
-	var permissions []string
-	var permissionsList []models.UserPermissionORM
-
-	query := h.DB.Model(&models.UserPermissionORM{})
-
-	if req.UserId != "" {
-		query = query.Where("user_id = ?  AND status = ?", &req.UserId, int32(models.Status_ACTIVE))
-	}
-	query = query.Select("permission").Find(&permissionsList)
-	if query.Error != nil && query.Error.Error() != "record not found" {
-		log.Println(query.Error)
-		return nil, status.Errorf(codes.FailedPrecondition,
-			"User already has permission")
-	}
-
-	// Convert UserColumnsList to models.User objects
-	for _, userColumns := range permissionsList {
-		permissions = append(permissions, userColumns.Permission)
+	permissions, err := userPermissions(h.DB, req.UserId, req.IncludeRoles)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
 	}
 
 	return &pb.ListUserPermissionsResponse{
@@ -254,11 +416,13 @@ func (h *Handler) ListUserPermissions(ctx context.Context, req *pb.ListUserPermi
 }
 
 func (h *Handler) AddUserPermission(ctx context.Context, req *models.UserPermission) (*models.UserPermission, error) {
+	if err := checkGrant(h.DB, req.Permission, req.ResourceType, req.ResourceId); err != nil {
+		return nil, err
+	}
 
 	var role models.UserPermissionORM
-	query := h.DB.First(&role, "user_id = ? AND permission = ? AND status = ?", req.User.Id, req.Permission, int32(models.Status_ACTIVE))
-	if query.Error != nil && query.Error.Error() != "record not found" {
-		log.Println(query.Error)
+	query := h.DB.First(&role, "user_id = ? AND permission = ? AND resource_type = ? AND resource_id = ? AND status = ?", req.User.Id, req.Permission, req.ResourceType, req.ResourceId, int32(models.Status_ACTIVE))
+	if query.Error == nil {
 		return nil, status.Errorf(codes.FailedPrecondition,
 			"User already has permission")
 	}
@@ -267,10 +431,21 @@ func (h *Handler) AddUserPermission(ctx context.Context, req *models.UserPermiss
 
 	role.UserId = &req.User.Id
 	role.Permission = req.Permission
+	role.ResourceType = req.ResourceType
+	role.ResourceId = req.ResourceId
 	role.CreatedAt = &now
 	role.UpdatedAt = &now
 	role.Status = int32(models.Status_ACTIVE)
-	h.DB.Create(&role)
+	err := h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&role).Error; err != nil {
+			return err
+		}
+		return rbac.BumpVersion(tx, []string{req.User.Id})
+	})
+	if err != nil {
+		log.Println("Error adding permission", err)
+		return nil, status.Errorf(codes.Internal, "Unable to add permission")
+	}
 
 	permission, _ := role.ToPB(ctx)
 
@@ -280,7 +455,7 @@ func (h *Handler) AddUserPermission(ctx context.Context, req *models.UserPermiss
 func (h *Handler) DeleteUserPermission(ctx context.Context, req *models.UserPermission) (*emptypb.Empty, error) {
 
 	var role models.UserPermissionORM
-	query := h.DB.First(&role, "user_id = ? AND permission = ? AND status = ?", req.User.Id, req.Permission, int32(models.Status_ACTIVE))
+	query := h.DB.First(&role, "user_id = ? AND permission = ? AND resource_type = ? AND resource_id = ? AND status = ?", req.User.Id, req.Permission, req.ResourceType, req.ResourceId, int32(models.Status_ACTIVE))
 	if query.Error != nil {
 		log.Println(query.Error)
 		return nil, status.Errorf(codes.FailedPrecondition,
@@ -293,15 +468,26 @@ func (h *Handler) DeleteUserPermission(ctx context.Context, req *models.UserPerm
 	role.Permission = req.Permission
 	role.Status = int32(models.Status_INACTIVE)
 	role.UpdatedAt = &now
-	h.DB.Save(&role)
+	err := h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Save(&role).Error; err != nil {
+			return err
+		}
+		return rbac.BumpVersion(tx, []string{req.User.Id})
+	})
+	if err != nil {
+		log.Println("Error removing permission", err)
+		return nil, status.Errorf(codes.Internal, "Unable to remove permission")
+	}
 
 	return &emptypb.Empty{}, nil
 }
 
+// UpdateUserPermissions replaces the user's global permissions. Grants
+// scoped to a resource are left alone.
 func (h *Handler) UpdateUserPermissions(ctx context.Context, req *pb.UpdateUserPermissionsRequest) (*pb.UpdateUserPermissionsResponse, error) {
 
 	var permissions []*models.UserPermissionORM
-	h.DB.Find(&permissions, "user_id = ? AND status = ?", req.UserId, int32(models.Status_ACTIVE))
+	h.DB.Find(&permissions, "user_id = ? AND resource_type = '' AND status = ?", req.UserId, int32(models.Status_ACTIVE))
 
 	var activePermissions []string
 	for _, obj := range permissions {
@@ -315,29 +501,48 @@ func (h *Handler) UpdateUserPermissions(ctx context.Context, req *pb.UpdateUserP
 	}
 
 	added, removed := findPermissionChanges(activePermissions, req.Permissions)
+	for _, permission := range added {
+		if err := checkGrant(h.DB, permission, "", ""); err != nil {
+			return nil, err
+		}
+	}
 
 	now := time.Now()
-	for _, v := range added {
-		permission := &models.UserPermissionORM{}
-
-		permission.UserId = &req.UserId
-		permission.Permission = v
-		permission.CreatedAt = &now
-		permission.UpdatedAt = &now
-		permission.Status = int32(models.Status_ACTIVE)
-		h.DB.Create(&permission)
-
-	}
+	err := h.DB.Transaction(func(tx *gorm.DB) error {
+		for _, v := range added {
+			permission := &models.UserPermissionORM{}
+
+			permission.UserId = &req.UserId
+			permission.Permission = v
+			permission.CreatedAt = &now
+			permission.UpdatedAt = &now
+			permission.Status = int32(models.Status_ACTIVE)
+			if err := tx.Create(&permission).Error; err != nil {
+				return err
+			}
+		}
 
-	for _, v := range removed {
-		var permission models.UserPermissionORM
-		h.DB.First(&permission, "user_id = ? AND permission = ? AND status = ?", req.UserId, v, int32(models.Status_ACTIVE))
+		for _, v := range removed {
+			var permission models.UserPermissionORM
+			tx.First(&permission, "user_id = ? AND permission = ? AND resource_type = '' AND status = ?", req.UserId, v, int32(models.Status_ACTIVE))
+
+			permission.UserId = &req.UserId
+			permission.Permission = v
+			permission.UpdatedAt = &now
+			permission.Status = int32(models.Status_INACTIVE)
+			if err := tx.Save(&permission).Error; err != nil {
+				return err
+			}
+		}
 
-		permission.UserId = &req.UserId
-		permission.Permission = v
-		permission.UpdatedAt = &now
-		permission.Status = int32(models.Status_INACTIVE)
-		h.DB.Save(&permission)
+		if len(added) == 0 && len(removed) == 0 {
+			return nil
+		}
+		return rbac.BumpVersion(tx, []string{req.UserId})
+	})
+	if err != nil {
+		log.Println("Error updating permissions", err)
+		return nil, status.Errorf(codes.Internal, "Unable to update permissions")
 	}
 
 	return &pb.UpdateUserPermissionsResponse{
@@ -388,6 +593,30 @@ func (h *Handler) CheckUserPasswordStatus(ctx context.Context, req *pb.CheckUser
 		HasPassword: hasPassword,
 		Enable2FA:   enable2FA,
 	}
+	if enable2FA {
+		response.RecoveryCodesRemaining = h.remainingRecoveryCodes(user.Id)
+	}
 
 	return response, nil
 }
+
+func (h *Handler) GetJWKS(ctx context.Context, req *emptypb.Empty) (*pb.JWKSResponse, error) {
+	jwks := h.JWT.JWKS()
+
+	keys := make([]*pb.JSONWebKey, 0, len(jwks.Keys))
+	for _, key := range jwks.Keys {
+		keys = append(keys, &pb.JSONWebKey{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+			Y:   key.Y,
+		})
+	}
+
+	return &pb.JWKSResponse{Keys: keys}, nil
+}
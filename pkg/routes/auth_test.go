@@ -0,0 +1,246 @@
+package routes
+
+import (
+	"context"
+	"database/sql/driver"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lerryjay/auth-grpc-service/pkg/notify"
+	"github.com/lerryjay/auth-grpc-service/pkg/password"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"github.com/lerryjay/auth-grpc-service/pkg/throttle"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestResetPasswordRejectsBadCodes(t *testing.T) {
+	const (
+		userID = "6f1c3b8e-4a8e-4c52-9a43-0d3f9a1e7b21"
+		otpID  = "0b9e4c1d-3f7a-4e2b-8c6d-5a1f2e3d4c5b"
+		code   = "123456"
+	)
+	issued := func(expiresAt time.Time, attempts int32) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "user_id", "purpose", "code_hash", "expires_at", "attempts", "created_at"}).
+			AddRow(otpID, userID, int32(models.OtpPurpose_PASSWORD_RESET), otpHash(otpID, code), expiresAt, attempts, time.Now())
+	}
+	noCode := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id"})
+	}
+	future := time.Now().Add(time.Hour)
+
+	tests := []struct {
+		name     string
+		token    string
+		expect   func(mock sqlmock.Sqlmock)
+		want     codes.Code
+		failures int
+	}{
+		{
+			name:  "wrong code",
+			token: "654321",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM "otps"`).WillReturnRows(issued(future, 0))
+				mock.ExpectExec(`UPDATE "otps" SET "attempts"`).WillReturnResult(sqlmock.NewResult(0, 1))
+			},
+			want:     codes.PermissionDenied,
+			failures: 1,
+		},
+		{
+			name:  "expired code",
+			token: code,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM "otps"`).WillReturnRows(issued(time.Now().Add(-time.Minute), 0))
+			},
+			want:     codes.PermissionDenied,
+			failures: 1,
+		},
+		{
+			name:  "used code",
+			token: code,
+			expect: func(mock sqlmock.Sqlmock) {
+				// Used codes have consumed_at set, so no current code is found
+				mock.ExpectQuery(`FROM "otps" .*consumed_at IS NULL`).WillReturnRows(noCode())
+			},
+			want:     codes.PermissionDenied,
+			failures: 1,
+		},
+		{
+			name:  "code used by a concurrent reset",
+			token: code,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM "otps"`).WillReturnRows(issued(future, 0))
+				mock.ExpectBegin()
+				mock.ExpectQuery(`FROM "otps"`).WillReturnRows(issued(future, 0))
+				mock.ExpectExec(`UPDATE "otps" SET "consumed_at"=.* consumed_at IS NULL`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			want:     codes.PermissionDenied,
+			failures: 1,
+		},
+		{
+			name:  "too many attempts",
+			token: code,
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM "otps"`).WillReturnRows(issued(future, 5))
+			},
+			want:     codes.ResourceExhausted,
+			failures: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, mock, store := newTestHandler(t)
+
+			mock.ExpectQuery(`FROM "users"`).WillReturnRows(
+				sqlmock.NewRows([]string{"id", "email", "password"}).AddRow(userID, "ada@example.com", "old-hash"))
+			tt.expect(mock)
+
+			_, err := h.ResetPassword(context.Background(), &pb.ResetPasswordRequest{
+				LoginId:              "ada@example.com",
+				Token:                tt.token,
+				Password:             "correct horse battery staple",
+				PasswordConfirmation: "correct horse battery staple",
+			})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("got %v, want %v (%v)", got, tt.want, err)
+			}
+			// Any unexpected statement, such as the password update, fails here
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+
+			record, err := store.Get(context.Background(), throttle.AccountKey(throttleOTP, userID))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if record.Failures != tt.failures {
+				t.Errorf("got %d recorded failures, want %d", record.Failures, tt.failures)
+			}
+		})
+	}
+}
+
+func TestChangePasswordRecordsOldHash(t *testing.T) {
+	const userID = "6f1c3b8e-4a8e-4c52-9a43-0d3f9a1e7b21"
+
+	h, mock, _ := newTestHandler(t)
+	h.PasswordPolicy = &password.Policy{HistorySize: 3}
+	oldHash, err := h.Hasher.Hash("old password")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	mock.ExpectQuery(`FROM "users"`).WillReturnRows(
+		sqlmock.NewRows([]string{"id", "email", "password"}).AddRow(userID, "ada@example.com", oldHash))
+	mock.ExpectQuery(`FROM "password_histories"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "users" SET "password"`).WillReturnResult(sqlmock.NewResult(0, 1))
+	expectInsert(mock, "password_histories", sqlmock.AnyArg(), oldHash, userID, sqlmock.AnyArg())
+	mock.ExpectExec(`DELETE FROM "password_histories"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`UPDATE "api_keys" SET "revoked_at"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(`UPDATE "sessions" SET "revoked_at"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(`UPDATE "refresh_tokens" SET "revoked_at"`).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+
+	_, err = h.ChangePassword(context.Background(), &pb.ChangePasswordRequest{
+		Id:          userID,
+		Oldpassword: "old password",
+		Newpassword: "new password",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+}
+
+// captured matches any argument, keeping its value for the test to inspect
+type captured struct {
+	value driver.Value
+}
+
+func (c *captured) Match(v driver.Value) bool {
+	c.value = v
+	return true
+}
+
+func TestForgotPasswordSendsCode(t *testing.T) {
+	const userID = "6f1c3b8e-4a8e-4c52-9a43-0d3f9a1e7b21"
+
+	tests := []struct {
+		name    string
+		loginID string
+		known   bool
+		channel notify.Channel
+		to      string
+	}{
+		{"by email", "ada@example.com", true, notify.Email, "ada@example.com"},
+		{"by telephone", "+2348012345678", true, notify.SMS, "+2348012345678"},
+		{"unknown login id", "eve@example.net", false, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, mock, _ := newTestHandler(t)
+			email, sms := &notify.MemoryNotifier{}, &notify.MemoryNotifier{}
+			h.Notifier = &notify.Service{Email: email, SMS: sms}
+
+			rows := sqlmock.NewRows([]string{"id", "email", "telephone", "firstname"})
+			if tt.known {
+				rows.AddRow(userID, "ada@example.com", "+2348012345678", "Ada")
+			}
+			mock.ExpectQuery(`FROM "users"`).WillReturnRows(rows)
+
+			codeHash, otpID := &captured{}, &captured{}
+			if tt.known {
+				mock.ExpectBegin()
+				mock.ExpectExec(`UPDATE "otps" SET "consumed_at"`).WillReturnResult(sqlmock.NewResult(0, 1))
+				expectInsert(mock, "otps", int32(0), codeHash, nil, sqlmock.AnyArg(), sqlmock.AnyArg(),
+					int32(models.OtpPurpose_PASSWORD_RESET), userID, otpID)
+				mock.ExpectCommit()
+			}
+
+			res, err := h.ForgotPassword(context.Background(), &pb.ForgotPasswordRequest{LoginId: tt.loginID})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+			// Known and unknown login ids get the same response
+			if res.Destination != maskLoginID(tt.loginID) || res.TokenExpiryDate == "" {
+				t.Errorf("got destination %q and expiry %q", res.Destination, res.TokenExpiryDate)
+			}
+
+			sent := append(email.Sent(), sms.Sent()...)
+			if !tt.known {
+				if len(sent) != 0 {
+					t.Errorf("got %d messages for an unknown login id, want none", len(sent))
+				}
+				return
+			}
+			if len(sent) != 1 {
+				t.Fatalf("got %d messages, want 1", len(sent))
+			}
+			msg := sent[0]
+			if msg.Channel != tt.channel || msg.To != tt.to {
+				t.Errorf("got %s to %q, want %s to %q", msg.Channel, msg.To, tt.channel, tt.to)
+			}
+
+			// The delivered code is the one stored for the reset
+			code := regexp.MustCompile(`\b\d{6}\b`).FindString(msg.Body)
+			id, _ := otpID.value.(string)
+			if code == "" || codeHash.value != otpHash(id, code) {
+				t.Errorf("message %q does not carry the stored code", msg.Body)
+			}
+		})
+	}
+}
@@ -0,0 +1,369 @@
+package routes
+
+import (
+	"context"
+	"crypto/subtle"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lerryjay/auth-grpc-service/pkg/authz"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"github.com/lerryjay/auth-grpc-service/pkg/rbac"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+const (
+	grantTypeClientCredentials = "client_credentials"
+	grantTypeAuthorizationCode = "authorization_code"
+)
+
+// tokenResponse is the token endpoint's successful response (RFC 6749
+// section 5.1), with the ID token of OpenID Connect sign ins
+type tokenResponse struct {
+	AccessToken string `json:"access_token"`
+	TokenType   string `json:"token_type"`
+	ExpiresIn   int64  `json:"expires_in"`
+	Scope       string `json:"scope,omitempty"`
+	IDToken     string `json:"id_token,omitempty"`
+}
+
+// oauthError is an OAuth2 error response (RFC 6749 section 5.2)
+type oauthError struct {
+	Code        string `json:"error"`
+	Description string `json:"error_description,omitempty"`
+}
+
+func (e *oauthError) Error() string {
+	return e.Code + ": " + e.Description
+}
+
+// statusError converts the error for the gRPC token endpoint
+func (e *oauthError) statusError() error {
+	switch e.Code {
+	case "invalid_client":
+		return status.Errorf(codes.Unauthenticated, "Invalid client credentials")
+	case "server_error":
+		return status.Errorf(codes.Internal, "An unexpected error occurred")
+	default:
+		return status.Error(codes.InvalidArgument, e.Description)
+	}
+}
+
+// verifyClientSecret authenticates an active client at the token endpoint
+func (h *Handler) verifyClientSecret(clientID, secret string) (*models.ClientORM, *oauthError) {
+	if clientID == "" || secret == "" {
+		return nil, &oauthError{"invalid_client", "Client authentication failed"}
+	}
+
+	var client models.ClientORM
+	if err := h.DB.First(&client, "id = ?", clientID).Error; err != nil {
+		log.Println("Token requested for unknown client", clientID)
+		return nil, &oauthError{"invalid_client", "Client authentication failed"}
+	}
+	if subtle.ConstantTimeCompare([]byte(helpers.HashToken(secret)), []byte(client.SecretHash)) != 1 ||
+		client.Status != int32(models.Status_ACTIVE) {
+		log.Println("Client authentication failed for", clientID)
+		return nil, &oauthError{"invalid_client", "Client authentication failed"}
+	}
+	return &client, nil
+}
+
+// clientCredentials implements the client credentials grant shared by the
+// gRPC and HTTP token endpoints
+func (h *Handler) clientCredentials(grantType, clientID, secret, scope string) (*pb.ClientTokenResponse, *oauthError) {
+	if grantType != grantTypeClientCredentials {
+		return nil, &oauthError{"unsupported_grant_type", "Only the client_credentials grant is supported"}
+	}
+	client, oerr := h.verifyClientSecret(clientID, secret)
+	if oerr != nil {
+		return nil, oerr
+	}
+
+	allowed := strings.Fields(client.Scopes)
+	scopes := strings.Fields(scope)
+	if len(scopes) == 0 {
+		scopes = allowed
+	}
+	for _, requested := range scopes {
+		granted := false
+		for _, pattern := range allowed {
+			if rbac.Match(pattern, requested) {
+				granted = true
+				break
+			}
+		}
+		if !granted {
+			return nil, &oauthError{"invalid_scope", "Scope " + requested + " is not allowed for this client"}
+		}
+	}
+
+	token, err := h.JWT.GenerateClientToken(client.Id, scopes)
+	if err != nil {
+		log.Println("Error generating client token", err)
+		return nil, &oauthError{"server_error", "Unable to issue token"}
+	}
+
+	return &pb.ClientTokenResponse{
+		AccessToken: token,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(h.JWT.ClientTokenTTL().Seconds()),
+		Scope:       strings.Join(scopes, " "),
+	}, nil
+}
+
+// authenticateClient checks a service token hasn't been revoked and the
+// client it was issued to is still active
+func (h *Handler) authenticateClient(claims *helpers.TokenClaims) (*authz.Identity, error) {
+	if err := h.checkTokenActive(claims); err != nil {
+		return nil, helpers.TokenStatusError(err)
+	}
+	return &authz.Identity{ClientID: claims.ClientId, Scopes: strings.Fields(claims.Scope)}, nil
+}
+
+// serveToken is the OAuth2 token endpoint for the client credentials and
+// authorization code grants. Clients authenticate with HTTP basic auth or
+// the client_id and client_secret form parameters.
+func (h *Handler) serveToken(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Pragma", "no-cache")
+
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, &oauthError{"invalid_request", "Unable to parse the request body"})
+		return
+	}
+
+	clientID, secret, oerr := requestClientCredentials(r)
+	if oerr != nil {
+		writeOAuthError(w, oerr)
+		return
+	}
+
+	var res *tokenResponse
+	switch grantType := r.PostForm.Get("grant_type"); grantType {
+	case grantTypeAuthorizationCode:
+		res, oerr = h.authorizationCode(clientID, secret, r.PostForm.Get("code"), r.PostForm.Get("redirect_uri"), r.PostForm.Get("code_verifier"))
+	default:
+		var granted *pb.ClientTokenResponse
+		granted, oerr = h.clientCredentials(grantType, clientID, secret, r.PostForm.Get("scope"))
+		if oerr == nil {
+			res = &tokenResponse{
+				AccessToken: granted.AccessToken,
+				TokenType:   granted.TokenType,
+				ExpiresIn:   granted.ExpiresIn,
+				Scope:       granted.Scope,
+			}
+		}
+	}
+	if oerr != nil {
+		writeOAuthError(w, oerr)
+		return
+	}
+
+	writeJSON(w, http.StatusOK, res)
+}
+
+// requestClientCredentials reads the client credentials sent to one of the
+// OAuth2 HTTP endpoints with basic auth or as form parameters
+func requestClientCredentials(r *http.Request) (string, string, *oauthError) {
+	clientID, secret := r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+	if user, pass, ok := r.BasicAuth(); ok {
+		if clientID != "" || secret != "" {
+			return "", "", &oauthError{"invalid_request", "Use only one client authentication method"}
+		}
+		// Basic auth credentials are form encoded first (RFC 6749 section 2.3.1)
+		clientID, _ = url.QueryUnescape(user)
+		secret, _ = url.QueryUnescape(pass)
+	}
+	return clientID, secret, nil
+}
+
+// writeOAuthError writes an OAuth2 error response with its status code
+func writeOAuthError(w http.ResponseWriter, oerr *oauthError) {
+	code := http.StatusBadRequest
+	switch oerr.Code {
+	case "invalid_client":
+		code = http.StatusUnauthorized
+		w.Header().Set("WWW-Authenticate", `Basic realm="token"`)
+	case "server_error":
+		code = http.StatusInternalServerError
+	}
+	writeJSON(w, code, oerr)
+}
+
+func (h *Handler) ClientToken(ctx context.Context, req *pb.ClientTokenRequest) (*pb.ClientTokenResponse, error) {
+	res, err := h.clientCredentials(req.GrantType, req.ClientId, req.ClientSecret, req.Scope)
+	if err != nil {
+		return nil, err.statusError()
+	}
+	return res, nil
+}
+
+// checkClientSettings validates the scopes and redirect URIs a client is
+// registered with
+func (h *Handler) checkClientSettings(scopes, redirectURIs []string) error {
+	for _, scope := range scopes {
+		if err := checkGrant(h.DB, scope, "", ""); err != nil {
+			return err
+		}
+	}
+	for _, uri := range redirectURIs {
+		// Redirect URIs are compared exactly, so they must be absolute and
+		// can't carry a fragment (RFC 6749 section 3.1.2)
+		parsed, err := url.Parse(uri)
+		if err != nil || parsed.Scheme == "" || parsed.Fragment != "" || strings.ContainsAny(uri, " \t\n") {
+			return status.Errorf(codes.InvalidArgument, "Invalid redirect URI %s", uri)
+		}
+	}
+	return nil
+}
+
+// clientResponse converts a client for a response, leaving out the secret hash
+func clientResponse(ctx context.Context, client models.ClientORM) *models.Client {
+	client.SecretHash = ""
+	res, _ := client.ToPB(ctx)
+	return &res
+}
+
+func (h *Handler) CreateClient(ctx context.Context, req *pb.CreateClientRequest) (*pb.ClientSecretResponse, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Client name is required")
+	}
+	if err := h.checkClientSettings(req.Scopes, req.RedirectUris); err != nil {
+		return nil, err
+	}
+
+	secret, err := helpers.GenerateOpaqueToken(32)
+	if err != nil {
+		log.Println("Error generating client secret", err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	now := time.Now()
+	client := models.ClientORM{
+		Id:              uuid.New().String(),
+		Name:            name,
+		SecretHash:      helpers.HashToken(secret),
+		Scopes:          strings.Join(req.Scopes, " "),
+		RedirectUris:    strings.Join(req.RedirectUris, " "),
+		Status:          int32(models.Status_ACTIVE),
+		SecretRotatedAt: &now,
+		CreatedAt:       &now,
+		UpdatedAt:       &now,
+	}
+	if err := h.DB.Create(&client).Error; err != nil {
+		log.Println("Error creating client", err)
+		return nil, status.Errorf(codes.Internal, "Unable to create client")
+	}
+
+	return &pb.ClientSecretResponse{Client: clientResponse(ctx, client), ClientSecret: secret}, nil
+}
+
+func (h *Handler) ListClients(ctx context.Context, req *emptypb.Empty) (*pb.ListClientsResponse, error) {
+	var clientList []models.ClientORM
+	if err := h.DB.Order("name").Find(&clientList).Error; err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "Unable to find clients")
+	}
+
+	clients := make([]*models.Client, 0, len(clientList))
+	for _, client := range clientList {
+		clients = append(clients, clientResponse(ctx, client))
+	}
+	return &pb.ListClientsResponse{Clients: clients}, nil
+}
+
+func (h *Handler) RotateClientSecret(ctx context.Context, req *pb.ClientRequest) (*pb.ClientSecretResponse, error) {
+	var client models.ClientORM
+	if err := h.DB.First(&client, "id = ?", req.Id).Error; err != nil {
+		return nil, status.Errorf(codes.NotFound, "Client not found")
+	}
+
+	secret, err := helpers.GenerateOpaqueToken(32)
+	if err != nil {
+		log.Println("Error generating client secret", err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	now := time.Now()
+	client.SecretHash = helpers.HashToken(secret)
+	client.SecretRotatedAt = &now
+	client.UpdatedAt = &now
+	query := h.DB.Model(&client).Updates(map[string]interface{}{
+		"secret_hash":       client.SecretHash,
+		"secret_rotated_at": now,
+		"updated_at":        now,
+	})
+	if query.Error != nil {
+		log.Println("Error rotating client secret", query.Error)
+		return nil, status.Errorf(codes.Internal, "Unable to rotate client secret")
+	}
+
+	return &pb.ClientSecretResponse{Client: clientResponse(ctx, client), ClientSecret: secret}, nil
+}
+
+func (h *Handler) DisableClient(ctx context.Context, req *pb.ClientRequest) (*models.Client, error) {
+	var client models.ClientORM
+	if err := h.DB.First(&client, "id = ?", req.Id).Error; err != nil {
+		return nil, status.Errorf(codes.NotFound, "Client not found")
+	}
+
+	now := time.Now()
+	client.Status = int32(models.Status_INACTIVE)
+	client.UpdatedAt = &now
+	query := h.DB.Model(&client).Updates(map[string]interface{}{
+		"status":     client.Status,
+		"updated_at": now,
+	})
+	if query.Error != nil {
+		log.Println("Error disabling client", query.Error)
+		return nil, status.Errorf(codes.Internal, "Unable to disable client")
+	}
+
+	return clientResponse(ctx, client), nil
+}
+
+func (h *Handler) UpdateClient(ctx context.Context, req *pb.UpdateClientRequest) (*models.Client, error) {
+	var client models.ClientORM
+	if err := h.DB.First(&client, "id = ?", req.Id).Error; err != nil {
+		return nil, status.Errorf(codes.NotFound, "Client not found")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Client name is required")
+	}
+	if err := h.checkClientSettings(req.Scopes, req.RedirectUris); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	client.Name = name
+	client.Scopes = strings.Join(req.Scopes, " ")
+	client.RedirectUris = strings.Join(req.RedirectUris, " ")
+	client.UpdatedAt = &now
+	query := h.DB.Model(&client).Updates(map[string]interface{}{
+		"name":          client.Name,
+		"scopes":        client.Scopes,
+		"redirect_uris": client.RedirectUris,
+		"updated_at":    now,
+	})
+	if query.Error != nil {
+		log.Println("Error updating client", query.Error)
+		return nil, status.Errorf(codes.Internal, "Unable to update client")
+	}
+
+	return clientResponse(ctx, client), nil
+}
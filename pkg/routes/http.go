@@ -0,0 +1,38 @@
+package routes
+
+import (
+	"encoding/json"
+	"log"
+	"net/http"
+)
+
+// HTTPHandler returns the endpoints served over plain HTTP alongside the gRPC server
+func (h *Handler) HTTPHandler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/jwks.json", h.serveJWKS)
+	mux.HandleFunc("/.well-known/openid-configuration", h.serveOpenIDConfiguration)
+	mux.HandleFunc("/oauth/authorize", h.serveAuthorize)
+	mux.HandleFunc("/oauth/token", h.serveToken)
+	mux.HandleFunc("/oauth/userinfo", h.serveUserinfo)
+	mux.HandleFunc("/oauth/introspect", h.serveIntrospect)
+	mux.HandleFunc("/oauth/revoke", h.serveRevoke)
+	return mux
+}
+
+func (h *Handler) serveJWKS(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	writeJSON(w, http.StatusOK, h.JWT.JWKS())
+}
+
+func writeJSON(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.Println("Error writing response", err)
+	}
+}
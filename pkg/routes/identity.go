@@ -0,0 +1,29 @@
+package routes
+
+import (
+	"errors"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/identity"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func identityError(err error) error {
+	switch {
+	case errors.Is(err, identity.ErrUnknownProvider):
+		return status.Errorf(codes.InvalidArgument, "Unsupported identity provider")
+	case errors.Is(err, identity.ErrEmailNotVerified):
+		return status.Errorf(codes.FailedPrecondition, "The identity provider has not verified this email address")
+	default:
+		return status.Errorf(codes.Unauthenticated, "Invalid identity token")
+	}
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,180 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lerryjay/auth-grpc-service/pkg/identity"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+// newLinkedIdentity records that the provider account in claims signs in as the user
+func newLinkedIdentity(userID string, claims *identity.Claims) *models.LinkedIdentityORM {
+	now := time.Now()
+	return &models.LinkedIdentityORM{
+		Id:       uuid.New().String(),
+		UserId:   &userID,
+		Provider: claims.Provider,
+		Subject:  claims.Subject,
+		Email:    claims.Email,
+		LinkedAt: &now,
+	}
+}
+
+// findLinkedUser returns the user the provider account is linked to
+func (h *Handler) findLinkedUser(claims *identity.Claims) (*models.UserORM, error) {
+	var link models.LinkedIdentityORM
+	err := h.DB.Preload("User").
+		First(&link, "provider = ? AND subject = ?", claims.Provider, claims.Subject).Error
+	if err != nil {
+		return nil, err
+	}
+	if link.User == nil {
+		return nil, gorm.ErrRecordNotFound
+	}
+	return link.User, nil
+}
+
+// signInMethods counts the ways the user can sign in other than the excluded
+// linked identity and passkey
+func (h *Handler) signInMethods(tx *gorm.DB, user *models.UserORM, excludeIdentityID, excludePasskeyID string) (int64, error) {
+	// The ids are uuid columns, so an empty id can't be compared with them
+	identityQuery := tx.Model(&models.LinkedIdentityORM{}).Where("user_id = ?", user.Id)
+	if excludeIdentityID != "" {
+		identityQuery = identityQuery.Where("id != ?", excludeIdentityID)
+	}
+	passkeyQuery := tx.Model(&models.PasskeyCredentialORM{}).Where("user_id = ?", user.Id)
+	if excludePasskeyID != "" {
+		passkeyQuery = passkeyQuery.Where("id != ?", excludePasskeyID)
+	}
+
+	var identities, passkeys int64
+	if err := identityQuery.Count(&identities).Error; err != nil {
+		return 0, err
+	}
+	if err := passkeyQuery.Count(&passkeys).Error; err != nil {
+		return 0, err
+	}
+
+	count := identities + passkeys
+	if user.Password != "" {
+		count++
+	}
+	return count, nil
+}
+
+func (h *Handler) LinkIdentity(ctx context.Context, req *pb.LinkIdentityRequest) (*models.LinkedIdentity, error) {
+	var user models.UserORM
+	query := h.DB.First(&user, "id = ?", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "User not found")
+	}
+
+	claims, err := h.Identity.Verify(ctx, req.Issuer, req.Token)
+	if err != nil {
+		log.Println("Error verifying identity token", err)
+		return nil, identityError(err)
+	}
+
+	var existing models.LinkedIdentityORM
+	query = h.DB.First(&existing, "provider = ? AND subject = ?", claims.Provider, claims.Subject)
+	if query.Error == nil {
+		if existing.UserId == nil || *existing.UserId != user.Id {
+			return nil, status.Errorf(codes.AlreadyExists,
+				"This account is already linked to another user")
+		}
+		linked, err := existing.ToPB(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"Could not convert linked identity %s", err)
+		}
+		return &linked, nil
+	}
+	if !errors.Is(query.Error, gorm.ErrRecordNotFound) {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	link := newLinkedIdentity(user.Id, claims)
+	if err := h.DB.Create(link).Error; err != nil {
+		log.Println("Error linking identity", err)
+		return nil, status.Errorf(codes.Internal, "Unable to link identity")
+	}
+
+	linked, err := link.ToPB(ctx)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal,
+			"Could not convert linked identity %s", err)
+	}
+	return &linked, nil
+}
+
+func (h *Handler) UnlinkIdentity(ctx context.Context, req *pb.UnlinkIdentityRequest) (*emptypb.Empty, error) {
+	var user models.UserORM
+	query := h.DB.First(&user, "id = ?", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "User not found")
+	}
+
+	errLastMethod := errors.New("last sign in method")
+	err := h.DB.Transaction(func(tx *gorm.DB) error {
+		var link models.LinkedIdentityORM
+		if err := tx.First(&link, "id = ? AND user_id = ?", req.Id, user.Id).Error; err != nil {
+			return err
+		}
+
+		remaining, err := h.signInMethods(tx, &user, link.Id, "")
+		if err != nil {
+			return err
+		}
+		if remaining == 0 {
+			return errLastMethod
+		}
+
+		return tx.Delete(&link).Error
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, status.Errorf(codes.NotFound, "Linked identity not found")
+	case errors.Is(err, errLastMethod):
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Set a password or link another account before removing your last sign in method")
+	default:
+		log.Println("Error unlinking identity", err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) ListLinkedIdentities(ctx context.Context, req *pb.ListLinkedIdentitiesRequest) (*pb.ListLinkedIdentitiesResponse, error) {
+	var links []models.LinkedIdentityORM
+	query := h.DB.Where("user_id = ?", req.UserId).Order("linked_at").Find(&links)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	res := &pb.ListLinkedIdentitiesResponse{}
+	for _, link := range links {
+		linked, err := link.ToPB(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"Could not convert linked identity %s", err)
+		}
+		res.Identities = append(res.Identities, &linked)
+	}
+
+	return res, nil
+}
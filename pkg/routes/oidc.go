@@ -0,0 +1,390 @@
+package routes
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lerryjay/auth-grpc-service/pkg/authz"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OpenID Connect scopes. Other requested scopes are ignored.
+const (
+	scopeOpenID  = "openid"
+	scopeProfile = "profile"
+	scopeEmail   = "email"
+	scopePhone   = "phone"
+)
+
+var oidcScopes = []string{scopeOpenID, scopeProfile, scopeEmail, scopePhone}
+
+// openIDConfiguration is the discovery document (OpenID Connect Discovery
+// section 3)
+type openIDConfiguration struct {
+	Issuer                            string   `json:"issuer"`
+	AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+	TokenEndpoint                     string   `json:"token_endpoint"`
+	UserinfoEndpoint                  string   `json:"userinfo_endpoint"`
+	JWKSURI                           string   `json:"jwks_uri"`
+	ResponseTypesSupported            []string `json:"response_types_supported"`
+	GrantTypesSupported               []string `json:"grant_types_supported"`
+	SubjectTypesSupported             []string `json:"subject_types_supported"`
+	IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+	ScopesSupported                   []string `json:"scopes_supported"`
+	TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+	CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+	ClaimsSupported                   []string `json:"claims_supported"`
+}
+
+// serveOpenIDConfiguration publishes the discovery document. APP_URL is the
+// issuer, so it has to be the address these HTTP endpoints are served on.
+func (h *Handler) serveOpenIDConfiguration(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+
+	issuer := h.JWT.Issuer()
+	base := strings.TrimSuffix(issuer, "/")
+	w.Header().Set("Cache-Control", "public, max-age=300")
+	writeJSON(w, http.StatusOK, openIDConfiguration{
+		Issuer:                            issuer,
+		AuthorizationEndpoint:             base + "/oauth/authorize",
+		TokenEndpoint:                     base + "/oauth/token",
+		UserinfoEndpoint:                  base + "/oauth/userinfo",
+		JWKSURI:                           base + "/.well-known/jwks.json",
+		ResponseTypesSupported:            []string{"code"},
+		GrantTypesSupported:               []string{grantTypeAuthorizationCode, grantTypeClientCredentials},
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{h.JWT.SigningAlgorithm()},
+		ScopesSupported:                   oidcScopes,
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post"},
+		CodeChallengeMethodsSupported:     []string{"S256"},
+		ClaimsSupported: []string{
+			"sub", "iss", "aud", "exp", "iat", "auth_time", "nonce", "name", "given_name",
+			"family_name", "preferred_username", "picture", "updated_at", "email",
+			"email_verified", "phone_number", "phone_number_verified",
+		},
+	})
+}
+
+func hasScope(scopes []string, scope string) bool {
+	for _, s := range scopes {
+		if s == scope {
+			return true
+		}
+	}
+	return false
+}
+
+// withQuery adds parameters to a URI's query string
+func withQuery(uri string, params url.Values) string {
+	parsed, err := url.Parse(uri)
+	if err != nil {
+		return uri
+	}
+	query := parsed.Query()
+	for name, values := range params {
+		for _, value := range values {
+			if value != "" {
+				query.Add(name, value)
+			}
+		}
+	}
+	parsed.RawQuery = query.Encode()
+	return parsed.String()
+}
+
+// checkAuthorization validates an authorization request and returns the
+// client and the OpenID Connect scopes requested. Errors about the client or
+// redirect URI can't be sent to the redirect URI, which is reported by the
+// returned bool being false.
+func (h *Handler) checkAuthorization(req *pb.AuthorizeRequest) (*models.ClientORM, []string, *oauthError, bool) {
+	var client models.ClientORM
+	if req.ClientId == "" || h.DB.First(&client, "id = ?", req.ClientId).Error != nil ||
+		client.Status != int32(models.Status_ACTIVE) {
+		return nil, nil, &oauthError{"invalid_request", "Unknown client"}, false
+	}
+	if req.RedirectUri == "" || !hasScope(strings.Fields(client.RedirectUris), req.RedirectUri) {
+		return nil, nil, &oauthError{"invalid_request", "Redirect URI is not registered for this client"}, false
+	}
+
+	if req.ResponseType != "code" {
+		return nil, nil, &oauthError{"unsupported_response_type", "Only the code response type is supported"}, true
+	}
+	var scopes []string
+	for _, scope := range strings.Fields(req.Scope) {
+		if hasScope(oidcScopes, scope) && !hasScope(scopes, scope) {
+			scopes = append(scopes, scope)
+		}
+	}
+	if !hasScope(scopes, scopeOpenID) {
+		return nil, nil, &oauthError{"invalid_scope", "The openid scope is required"}, true
+	}
+	if req.CodeChallenge == "" {
+		return nil, nil, &oauthError{"invalid_request", "A PKCE code_challenge is required"}, true
+	}
+	if req.CodeChallengeMethod != "S256" {
+		return nil, nil, &oauthError{"invalid_request", "Only the S256 code_challenge_method is supported"}, true
+	}
+	return &client, scopes, nil, false
+}
+
+// authorizationErrorURI sends an error back to the client's redirect URI
+func authorizationErrorURI(req *pb.AuthorizeRequest, oerr *oauthError) string {
+	return withQuery(req.RedirectUri, url.Values{
+		"error":             {oerr.Code},
+		"error_description": {oerr.Description},
+		"state":             {req.State},
+	})
+}
+
+// serveAuthorize is the authorization endpoint. Valid requests are passed
+// on to the login page, which signs the user in and approves the request
+// with the Authorize RPC.
+func (h *Handler) serveAuthorize(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if h.OIDCLoginURL == "" {
+		writeJSON(w, http.StatusServiceUnavailable, &oauthError{"temporarily_unavailable", "OpenID Connect sign in is not enabled"})
+		return
+	}
+
+	query := r.URL.Query()
+	req := &pb.AuthorizeRequest{
+		ResponseType:        query.Get("response_type"),
+		ClientId:            query.Get("client_id"),
+		RedirectUri:         query.Get("redirect_uri"),
+		Scope:               query.Get("scope"),
+		State:               query.Get("state"),
+		Nonce:               query.Get("nonce"),
+		CodeChallenge:       query.Get("code_challenge"),
+		CodeChallengeMethod: query.Get("code_challenge_method"),
+	}
+	if _, _, oerr, redirect := h.checkAuthorization(req); oerr != nil {
+		if !redirect {
+			writeJSON(w, http.StatusBadRequest, oerr)
+			return
+		}
+		http.Redirect(w, r, authorizationErrorURI(req, oerr), http.StatusFound)
+		return
+	}
+
+	http.Redirect(w, r, withQuery(h.OIDCLoginURL, query), http.StatusFound)
+}
+
+func (h *Handler) Authorize(ctx context.Context, req *pb.AuthorizeRequest) (*pb.AuthorizeResponse, error) {
+	caller, ok := authz.Caller(ctx)
+	if !ok || caller.UserID == "" || caller.APIKeyID != "" {
+		return nil, status.Errorf(codes.PermissionDenied, "Only users can approve sign in requests")
+	}
+
+	client, scopes, oerr, redirect := h.checkAuthorization(req)
+	if oerr != nil {
+		if !redirect {
+			return nil, status.Error(codes.InvalidArgument, oerr.Description)
+		}
+		return &pb.AuthorizeResponse{RedirectUri: authorizationErrorURI(req, oerr)}, nil
+	}
+
+	code, err := helpers.GenerateOpaqueToken(32)
+	if err != nil {
+		log.Println("Error generating authorization code", err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	now := time.Now()
+	authTime := now
+	if caller.SessionID != "" {
+		var session models.SessionORM
+		if err := h.DB.First(&session, "id = ?", caller.SessionID).Error; err == nil && session.CreatedAt != nil {
+			authTime = *session.CreatedAt
+		}
+	}
+	expiresAt := now.Add(h.AuthorizationCodeTTL)
+	record := models.AuthorizationCodeORM{
+		Id:            uuid.New().String(),
+		ClientId:      &client.Id,
+		UserId:        &caller.UserID,
+		CodeHash:      helpers.HashToken(code),
+		RedirectUri:   req.RedirectUri,
+		Scope:         strings.Join(scopes, " "),
+		Nonce:         req.Nonce,
+		CodeChallenge: req.CodeChallenge,
+		AuthTime:      &authTime,
+		ExpiresAt:     &expiresAt,
+		CreatedAt:     &now,
+	}
+	if err := h.DB.Create(&record).Error; err != nil {
+		log.Println("Error creating authorization code", err)
+		return nil, status.Errorf(codes.Internal, "Unable to approve sign in")
+	}
+
+	return &pb.AuthorizeResponse{
+		RedirectUri: withQuery(req.RedirectUri, url.Values{"code": {code}, "state": {req.State}}),
+	}, nil
+}
+
+// pkceVerified checks a code_verifier against the S256 challenge it was
+// issued for (RFC 7636 section 4.6)
+func pkceVerified(challenge, verifier string) bool {
+	if len(verifier) < 43 || len(verifier) > 128 {
+		return false
+	}
+	sum := sha256.Sum256([]byte(verifier))
+	expected := base64.RawURLEncoding.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(challenge)) == 1
+}
+
+// authorizationCode exchanges an authorization code for an access token and
+// an ID token
+func (h *Handler) authorizationCode(clientID, secret, code, redirectURI, verifier string) (*tokenResponse, *oauthError) {
+	client, oerr := h.verifyClientSecret(clientID, secret)
+	if oerr != nil {
+		return nil, oerr
+	}
+	if code == "" {
+		return nil, &oauthError{"invalid_request", "code is required"}
+	}
+
+	var record models.AuthorizationCodeORM
+	if err := h.DB.First(&record, "code_hash = ?", helpers.HashToken(code)).Error; err != nil {
+		return nil, &oauthError{"invalid_grant", "Invalid authorization code"}
+	}
+	if record.ClientId == nil || *record.ClientId != client.Id || record.RedirectUri != redirectURI {
+		return nil, &oauthError{"invalid_grant", "Invalid authorization code"}
+	}
+	if !pkceVerified(record.CodeChallenge, verifier) {
+		return nil, &oauthError{"invalid_grant", "Invalid code_verifier"}
+	}
+
+	// Claim the code so it can only be exchanged once
+	now := time.Now()
+	consume := h.DB.Model(&models.AuthorizationCodeORM{}).
+		Where("id = ? AND consumed_at IS NULL AND expires_at > ?", record.Id, now).
+		Update("consumed_at", now)
+	if consume.Error != nil {
+		log.Println("Error consuming authorization code", consume.Error)
+		return nil, &oauthError{"server_error", "Unable to issue token"}
+	}
+	if consume.RowsAffected == 0 {
+		return nil, &oauthError{"invalid_grant", "Authorization code has expired or was already used"}
+	}
+
+	var user models.UserORM
+	if record.UserId == nil || h.DB.First(&user, "id = ?", *record.UserId).Error != nil {
+		return nil, &oauthError{"invalid_grant", "Invalid authorization code"}
+	}
+
+	scopes := strings.Fields(record.Scope)
+	accessToken, err := h.JWT.GenerateOIDCAccessToken(user.Id, client.Id, scopes)
+	if err != nil {
+		log.Println("Error generating access token", err)
+		return nil, &oauthError{"server_error", "Unable to issue token"}
+	}
+
+	claims := userInfoClaims(&user, scopes)
+	if record.Nonce != "" {
+		claims["nonce"] = record.Nonce
+	}
+	if record.AuthTime != nil {
+		claims["auth_time"] = record.AuthTime.Unix()
+	}
+	idToken, err := h.JWT.GenerateIDToken(user.Id, client.Id, claims)
+	if err != nil {
+		log.Println("Error generating ID token", err)
+		return nil, &oauthError{"server_error", "Unable to issue token"}
+	}
+
+	return &tokenResponse{
+		AccessToken: accessToken,
+		TokenType:   "Bearer",
+		ExpiresIn:   int64(h.JWT.AccessTokenTTL().Seconds()),
+		Scope:       record.Scope,
+		IDToken:     idToken,
+	}, nil
+}
+
+// userInfoClaims returns the user's profile claims released by the scopes
+// (OpenID Connect Core section 5.4)
+func userInfoClaims(user *models.UserORM, scopes []string) map[string]interface{} {
+	claims := map[string]interface{}{"sub": user.Id}
+	set := func(name, value string) {
+		if value != "" {
+			claims[name] = value
+		}
+	}
+
+	if hasScope(scopes, scopeProfile) {
+		set("name", strings.TrimSpace(user.Firstname+" "+user.Lastname))
+		set("given_name", user.Firstname)
+		set("family_name", user.Lastname)
+		set("preferred_username", user.Username)
+		set("picture", user.ImageUrl)
+		if user.UpdatedAt != nil {
+			claims["updated_at"] = user.UpdatedAt.Unix()
+		}
+	}
+	if hasScope(scopes, scopeEmail) && user.Email != "" {
+		claims["email"] = user.Email
+		claims["email_verified"] = user.EmailVerified
+	}
+	if hasScope(scopes, scopePhone) && user.Telephone != "" {
+		claims["phone_number"] = user.Telephone
+		claims["phone_number_verified"] = user.PhoneVerified
+	}
+	return claims
+}
+
+// serveUserinfo returns the profile of the user an OpenID Connect access
+// token was issued for
+func (h *Handler) serveUserinfo(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+
+	auth := r.Header.Get("Authorization")
+	var claims *helpers.TokenClaims
+	var err error = helpers.ErrTokenMalformed
+	if len(auth) > 7 && strings.EqualFold(auth[:7], "bearer ") {
+		claims, err = h.JWT.VerifyOIDCAccessToken(strings.TrimSpace(auth[7:]))
+	}
+
+	var user models.UserORM
+	if err == nil {
+		err = h.checkTokenActive(claims)
+	}
+	if err == nil {
+		err = h.DB.First(&user, "id = ?", claims.Subject).Error
+	}
+	if err != nil {
+		w.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		writeJSON(w, http.StatusUnauthorized, &oauthError{"invalid_token", "Invalid access token"})
+		return
+	}
+
+	scopes := strings.Fields(claims.Scope)
+	if !hasScope(scopes, scopeOpenID) {
+		w.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope"`)
+		writeJSON(w, http.StatusForbidden, &oauthError{"insufficient_scope", "The openid scope is required"})
+		return
+	}
+
+	writeJSON(w, http.StatusOK, userInfoClaims(&user, scopes))
+}
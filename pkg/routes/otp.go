@@ -0,0 +1,120 @@
+package routes
+
+import (
+	"crypto/subtle"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+var (
+	errOTPInvalid  = errors.New("invalid or expired code")
+	errOTPAttempts = errors.New("too many attempts")
+)
+
+// otpHash salts the code with the row id so equal codes never share a hash
+func otpHash(id, code string) string {
+	return helpers.HashToken(id + ":" + code)
+}
+
+// issueOTP replaces any outstanding code the user has for the purpose with a
+// new one and returns the plaintext code to deliver
+func (h *Handler) issueOTP(db *gorm.DB, userID string, purpose models.OtpPurpose) (string, time.Time, error) {
+	code := helpers.GetOTP(6, true)
+	expiresAt, err := h.storeOTP(db, userID, purpose, code)
+	return code, expiresAt, err
+}
+
+// storeOTP replaces any outstanding code the user has for the purpose with code
+func (h *Handler) storeOTP(db *gorm.DB, userID string, purpose models.OtpPurpose, code string) (time.Time, error) {
+	now := time.Now()
+	expiresAt := now.Add(h.OTPTTL)
+
+	otp := models.OtpORM{
+		Id:        uuid.New().String(),
+		UserId:    &userID,
+		Purpose:   int32(purpose),
+		ExpiresAt: &expiresAt,
+		CreatedAt: &now,
+	}
+	otp.CodeHash = otpHash(otp.Id, code)
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&models.OtpORM{}).
+			Where("user_id = ? AND purpose = ? AND consumed_at IS NULL", userID, int32(purpose)).
+			Update("consumed_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		return tx.Create(&otp).Error
+	})
+	return expiresAt, err
+}
+
+// checkOTP validates the user's current code for the purpose. With consume
+// set the code is marked used and can't be presented again.
+func (h *Handler) checkOTP(db *gorm.DB, userID string, purpose models.OtpPurpose, code string, consume bool) error {
+	var otp models.OtpORM
+	query := db.Order("created_at desc").First(&otp, "user_id = ? AND purpose = ? AND consumed_at IS NULL", userID, int32(purpose))
+	if query.Error != nil {
+		return errOTPInvalid
+	}
+
+	if otp.ExpiresAt == nil || time.Now().After(*otp.ExpiresAt) {
+		return errOTPInvalid
+	}
+
+	if otp.Attempts >= h.OTPMaxAttempts {
+		return errOTPAttempts
+	}
+
+	if subtle.ConstantTimeCompare([]byte(otpHash(otp.Id, code)), []byte(otp.CodeHash)) != 1 {
+		// Counted outside of db so a rolled back transaction can't undo it
+		result := h.DB.Model(&models.OtpORM{}).Where("id = ?", otp.Id).Update("attempts", gorm.Expr("attempts + 1"))
+		if result.Error != nil {
+			log.Println("Error counting OTP attempt", result.Error)
+		}
+		return errOTPInvalid
+	}
+
+	if consume {
+		result := db.Model(&models.OtpORM{}).
+			Where("id = ? AND consumed_at IS NULL", otp.Id).
+			Update("consumed_at", time.Now())
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errOTPInvalid
+		}
+	}
+
+	return nil
+}
+
+// isOTPFailure reports whether err was caused by a wrong code rather than
+// by something going wrong on our side
+func isOTPFailure(err error) bool {
+	return errors.Is(err, errOTPInvalid) || errors.Is(err, errOTPAttempts)
+}
+
+func otpError(err error) error {
+	switch {
+	case errors.Is(err, errOTPAttempts):
+		return status.Errorf(codes.ResourceExhausted,
+			"Too many attempts. Request a new code")
+	case errors.Is(err, errOTPInvalid):
+		return status.Errorf(codes.PermissionDenied,
+			"Invalid or expired authentication token")
+	default:
+		log.Println(err)
+		return status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+}
@@ -0,0 +1,407 @@
+package routes
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"github.com/lerryjay/auth-grpc-service/pkg/webauthn"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+var errPasskeyChallenge = errors.New("unknown or expired passkey challenge")
+
+func encodeBinary(b []byte) string {
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+func decodeBinary(s string) []byte {
+	b, _ := base64.RawURLEncoding.DecodeString(s)
+	return b
+}
+
+func (h *Handler) requirePasskeys() error {
+	if h.WebAuthn == nil {
+		return status.Errorf(codes.FailedPrecondition, "Passkeys are not enabled")
+	}
+	return nil
+}
+
+// newPasskeyChallenge stores a random challenge for the ceremony. userID is
+// empty when the user will pick a passkey without saying who they are.
+func (h *Handler) newPasskeyChallenge(userID, ceremony string) ([]byte, error) {
+	challenge := make([]byte, 32)
+	if _, err := rand.Read(challenge); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(h.WebAuthn.Timeout)
+	record := models.WebauthnChallengeORM{
+		Id:            uuid.New().String(),
+		ChallengeHash: helpers.HashToken(encodeBinary(challenge)),
+		Ceremony:      ceremony,
+		ExpiresAt:     &expiresAt,
+		CreatedAt:     &now,
+	}
+	if userID != "" {
+		record.UserId = &userID
+	}
+
+	if err := h.DB.Create(&record).Error; err != nil {
+		return nil, err
+	}
+	return challenge, nil
+}
+
+// consumePasskeyChallenge marks a challenge we issued for the user as used
+func consumePasskeyChallenge(tx *gorm.DB, challenge []byte, ceremony, userID string) error {
+	now := time.Now()
+	result := tx.Model(&models.WebauthnChallengeORM{}).
+		Where("challenge_hash = ? AND ceremony = ? AND consumed_at IS NULL AND expires_at > ?", helpers.HashToken(encodeBinary(challenge)), ceremony, now).
+		Where("user_id IS NULL OR user_id = ?", userID).
+		Update("consumed_at", now)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errPasskeyChallenge
+	}
+	return nil
+}
+
+func (h *Handler) userPasskeys(userID string) ([]models.PasskeyCredentialORM, error) {
+	var passkeys []models.PasskeyCredentialORM
+	err := h.DB.Where("user_id = ?", userID).Order("created_at").Find(&passkeys).Error
+	return passkeys, err
+}
+
+func passkeyError(err error) error {
+	switch {
+	case errors.Is(err, errPasskeyChallenge), errors.Is(err, webauthn.ErrInvalidResponse), errors.Is(err, webauthn.ErrSignCount):
+		return status.Errorf(codes.PermissionDenied, "Passkey verification failed")
+	case errors.Is(err, webauthn.ErrUnsupportedKey):
+		return status.Errorf(codes.InvalidArgument, "Passkey algorithm is not supported")
+	default:
+		log.Println(err)
+		return status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+}
+
+func (h *Handler) BeginPasskeyRegistration(ctx context.Context, req *pb.BeginPasskeyRegistrationRequest) (*pb.PasskeyCreationOptions, error) {
+	if err := h.requirePasskeys(); err != nil {
+		return nil, err
+	}
+
+	var user models.UserORM
+	query := h.DB.First(&user, "id = ?", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "User not found")
+	}
+
+	existing, err := h.userPasskeys(user.Id)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	challenge, err := h.newPasskeyChallenge(user.Id, webauthn.TypeCreate)
+	if err != nil {
+		log.Println("Error creating passkey challenge", err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	options := &pb.PasskeyCreationOptions{
+		Challenge:        challenge,
+		RpId:             h.WebAuthn.ID,
+		RpName:           h.WebAuthn.Name,
+		UserHandle:       []byte(user.Id),
+		UserName:         firstNonEmpty(user.Email, user.Username, user.Telephone),
+		UserDisplayName:  strings.TrimSpace(user.Firstname + " " + user.Lastname),
+		Algorithms:       []int32{webauthn.AlgES256, webauthn.AlgRS256},
+		TimeoutMs:        h.WebAuthn.Timeout.Milliseconds(),
+		UserVerification: "preferred",
+		ResidentKey:      "preferred",
+		Attestation:      "none",
+	}
+	for _, passkey := range existing {
+		options.ExcludeCredentials = append(options.ExcludeCredentials, decodeBinary(passkey.CredentialId))
+	}
+
+	return options, nil
+}
+
+func (h *Handler) FinishPasskeyRegistration(ctx context.Context, req *pb.FinishPasskeyRegistrationRequest) (*models.PasskeyCredential, error) {
+	if err := h.requirePasskeys(); err != nil {
+		return nil, err
+	}
+
+	var user models.UserORM
+	query := h.DB.First(&user, "id = ?", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "User not found")
+	}
+
+	credential, challenge, err := h.WebAuthn.VerifyRegistration(req.ClientDataJSON, req.AttestationObject)
+	if err != nil {
+		log.Println("Error verifying passkey registration", err)
+		return nil, passkeyError(err)
+	}
+
+	now := time.Now()
+	passkey := models.PasskeyCredentialORM{
+		Id:           uuid.New().String(),
+		UserId:       &user.Id,
+		CredentialId: encodeBinary(credential.ID),
+		PublicKey:    encodeBinary(credential.PublicKey),
+		SignCount:    int64(credential.SignCount),
+		Aaguid:       encodeBinary(credential.AAGUID),
+		Name:         firstNonEmpty(req.Name, "Passkey"),
+		CreatedAt:    &now,
+	}
+
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := consumePasskeyChallenge(tx, challenge, webauthn.TypeCreate, user.Id); err != nil {
+			return err
+		}
+		var count int64
+		if err := tx.Model(&models.PasskeyCredentialORM{}).Where("credential_id = ?", passkey.CredentialId).Count(&count).Error; err != nil {
+			return err
+		}
+		if count > 0 {
+			return status.Errorf(codes.AlreadyExists, "This passkey is already registered")
+		}
+		return tx.Create(&passkey).Error
+	})
+	if _, ok := status.FromError(err); ok && err != nil {
+		return nil, err
+	}
+	if err != nil {
+		log.Println("Error saving passkey", err)
+		return nil, passkeyError(err)
+	}
+
+	res, _ := passkey.ToPB(ctx)
+	return &res, nil
+}
+
+func (h *Handler) BeginPasskeyLogin(ctx context.Context, req *pb.BeginPasskeyLoginRequest) (*pb.PasskeyRequestOptions, error) {
+	if err := h.requirePasskeys(); err != nil {
+		return nil, err
+	}
+
+	var userID string
+	switch {
+	case req.MfaToken != "":
+		claims, err := h.verifyMFAToken(req.MfaToken)
+		if err != nil {
+			return nil, err
+		}
+		userID = claims.Subject
+	case req.LoginId != "":
+		var user models.UserORM
+		query := h.DB.First(&user, "email = ? OR username = ? OR telephone = ?", req.LoginId, req.LoginId, req.LoginId)
+		if query.Error != nil {
+			log.Println(query.Error)
+			return nil, status.Errorf(codes.NotFound, "User not found")
+		}
+		userID = user.Id
+	}
+
+	options := &pb.PasskeyRequestOptions{
+		RpId:             h.WebAuthn.ID,
+		TimeoutMs:        h.WebAuthn.Timeout.Milliseconds(),
+		UserVerification: "required",
+	}
+	if req.MfaToken != "" {
+		// The password was the first factor, so presence is enough
+		options.UserVerification = "discouraged"
+	}
+
+	if userID != "" {
+		passkeys, err := h.userPasskeys(userID)
+		if err != nil {
+			log.Println(err)
+			return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+		}
+		if len(passkeys) == 0 {
+			return nil, status.Errorf(codes.FailedPrecondition, "No passkeys are registered for this user")
+		}
+		for _, passkey := range passkeys {
+			options.AllowCredentials = append(options.AllowCredentials, decodeBinary(passkey.CredentialId))
+		}
+	}
+
+	challenge, err := h.newPasskeyChallenge(userID, webauthn.TypeGet)
+	if err != nil {
+		log.Println("Error creating passkey challenge", err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+	options.Challenge = challenge
+
+	return options, nil
+}
+
+// FinishPasskeyLogin signs the user in with a passkey. A passkey that
+// verified the user is both something they have and something they know or
+// are, so it satisfies two-factor authentication on its own. With an MFA
+// token it completes a password login as the second factor instead.
+func (h *Handler) FinishPasskeyLogin(ctx context.Context, req *pb.FinishPasskeyLoginRequest) (*pb.LoginUserResponse, error) {
+	if err := h.requirePasskeys(); err != nil {
+		return nil, err
+	}
+
+	var passkey models.PasskeyCredentialORM
+	query := h.DB.Preload("User").First(&passkey, "credential_id = ?", encodeBinary(req.CredentialId))
+	if query.Error != nil || passkey.User == nil {
+		log.Println("Unknown passkey", query.Error)
+		return nil, status.Errorf(codes.PermissionDenied, "Passkey verification failed")
+	}
+	user := passkey.User
+
+	if len(req.UserHandle) > 0 && string(req.UserHandle) != user.Id {
+		return nil, status.Errorf(codes.PermissionDenied, "Passkey verification failed")
+	}
+
+	var mfaClaims *helpers.TokenClaims
+	if req.MfaToken != "" {
+		claims, err := h.verifyMFAToken(req.MfaToken)
+		if err != nil {
+			return nil, err
+		}
+		if claims.Subject != user.Id {
+			return nil, status.Errorf(codes.PermissionDenied, "Passkey verification failed")
+		}
+		mfaClaims = claims
+	}
+
+	result, err := h.WebAuthn.VerifyAssertion(webauthn.Assertion{
+		ClientDataJSON:    req.ClientDataJSON,
+		AuthenticatorData: req.AuthenticatorData,
+		Signature:         req.Signature,
+	}, decodeBinary(passkey.PublicKey), uint32(passkey.SignCount))
+	if err != nil {
+		log.Println("Error verifying passkey assertion", err)
+		return nil, passkeyError(err)
+	}
+	if req.MfaToken == "" && !result.UserVerified {
+		return nil, status.Errorf(codes.PermissionDenied,
+			"The passkey must verify the user to sign in without a password")
+	}
+
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := consumePasskeyChallenge(tx, result.Challenge, webauthn.TypeGet, user.Id); err != nil {
+			return err
+		}
+		// Conditional on the count we checked, so concurrent use of a cloned
+		// authenticator can't both succeed
+		update := tx.Model(&models.PasskeyCredentialORM{}).
+			Where("id = ? AND sign_count = ?", passkey.Id, passkey.SignCount).
+			Updates(map[string]interface{}{
+				"sign_count":   int64(result.SignCount),
+				"last_used_at": time.Now(),
+			})
+		if update.Error != nil {
+			return update.Error
+		}
+		if update.RowsAffected == 0 {
+			return webauthn.ErrSignCount
+		}
+		return nil
+	})
+	if err != nil {
+		log.Println("Error completing passkey login", err)
+		return nil, passkeyError(err)
+	}
+
+	if mfaClaims != nil {
+		if err := h.consumeMFAToken(mfaClaims); err != nil {
+			return nil, err
+		}
+	} else if !h.loginVerified(user) {
+		return nil, notVerifiedError(user)
+	}
+
+	response, err := h.issueTokens(ctx, h.DB, user, "")
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal,
+			"Error authenticating user!")
+	}
+
+	response.Message = "Login Successful"
+	return response, nil
+}
+
+func (h *Handler) ListPasskeys(ctx context.Context, req *pb.ListPasskeysRequest) (*pb.ListPasskeysResponse, error) {
+	passkeys, err := h.userPasskeys(req.UserId)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	res := &pb.ListPasskeysResponse{}
+	for _, obj := range passkeys {
+		passkey, err := obj.ToPB(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"Could not convert passkey %s", err)
+		}
+		res.Passkeys = append(res.Passkeys, &passkey)
+	}
+
+	return res, nil
+}
+
+func (h *Handler) DeletePasskey(ctx context.Context, req *pb.DeletePasskeyRequest) (*emptypb.Empty, error) {
+	var user models.UserORM
+	query := h.DB.First(&user, "id = ?", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "User not found")
+	}
+
+	errLastMethod := errors.New("last sign in method")
+	err := h.DB.Transaction(func(tx *gorm.DB) error {
+		var passkey models.PasskeyCredentialORM
+		if err := tx.First(&passkey, "id = ? AND user_id = ?", req.Id, user.Id).Error; err != nil {
+			return err
+		}
+
+		remaining, err := h.signInMethods(tx, &user, "", passkey.Id)
+		if err != nil {
+			return err
+		}
+		if remaining == 0 {
+			return errLastMethod
+		}
+
+		return tx.Delete(&passkey).Error
+	})
+	switch {
+	case err == nil:
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, status.Errorf(codes.NotFound, "Passkey not found")
+	case errors.Is(err, errLastMethod):
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Set a password or add another sign in method before removing your last passkey")
+	default:
+		log.Println("Error deleting passkey", err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	return &emptypb.Empty{}, nil
+}
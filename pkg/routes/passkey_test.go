@@ -0,0 +1,281 @@
+package routes
+
+import (
+	"context"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	"github.com/lerryjay/auth-grpc-service/pkg/webauthn"
+	"github.com/lerryjay/auth-grpc-service/pkg/webauthn/webauthntest"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	passkeyUserID = "6f1c3b8e-4a8e-4c52-9a43-0d3f9a1e7b21"
+	passkeyID     = "9d2a7c4e-1b3f-4e8a-a6c5-7f0e2d1b3a49"
+	testRPID      = "example.com"
+	testOrigin    = "https://example.com"
+)
+
+func newPasskeyHandler(t *testing.T) (*Handler, sqlmock.Sqlmock) {
+	t.Helper()
+
+	h, mock, _ := newTestHandler(t)
+	h.WebAuthn = &webauthn.RelyingParty{
+		ID:      testRPID,
+		Name:    "Example",
+		Origins: []string{testOrigin},
+		Timeout: 5 * time.Minute,
+	}
+
+	issueTestTokens(t, h)
+	return h, mock
+}
+
+func passkeyUserRows() *sqlmock.Rows {
+	return sqlmock.NewRows([]string{"id", "email", "firstname"}).
+		AddRow(passkeyUserID, "ada@example.com", "Ada")
+}
+
+// expectChallengeConsumed expects the challenge to be marked used, with
+// found rows matching. Zero means it was already used or never issued.
+func expectChallengeConsumed(mock sqlmock.Sqlmock, challenge []byte, ceremony string, found int64) {
+	mock.ExpectExec(`UPDATE "webauthn_challenges" SET "consumed_at"`).
+		WithArgs(sqlmock.AnyArg(), helpers.HashToken(encodeBinary(challenge)), ceremony, sqlmock.AnyArg(), passkeyUserID).
+		WillReturnResult(sqlmock.NewResult(0, found))
+}
+
+// register creates the credential on the authenticator, returning what the
+// relying party stores for it
+func register(t *testing.T, rp *webauthn.RelyingParty, a *webauthntest.Authenticator) *webauthn.Credential {
+	t.Helper()
+
+	challenge := make([]byte, 32)
+	if _, err := rand.Read(challenge); err != nil {
+		t.Fatal(err)
+	}
+	clientDataJSON, attestation, err := a.Register(challenge)
+	if err != nil {
+		t.Fatal(err)
+	}
+	credential, _, err := rp.VerifyRegistration(clientDataJSON, attestation)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return credential
+}
+
+func TestPasskeyRegistration(t *testing.T) {
+	tests := []struct {
+		name      string
+		requireUV bool
+		modify    func(a *webauthntest.Authenticator)
+		// Statements run once the response has been verified
+		finish func(mock sqlmock.Sqlmock, challenge []byte)
+		want   codes.Code
+	}{
+		{
+			name: "registers the passkey",
+			finish: func(mock sqlmock.Sqlmock, challenge []byte) {
+				mock.ExpectBegin()
+				expectChallengeConsumed(mock, challenge, webauthn.TypeCreate, 1)
+				mock.ExpectQuery(`SELECT count\(\*\) FROM "passkey_credentials"`).
+					WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+				expectInsert(mock, "passkey_credentials")
+				mock.ExpectCommit()
+			},
+			want: codes.OK,
+		},
+		{
+			name:   "wrong origin",
+			modify: func(a *webauthntest.Authenticator) { a.Origin = "https://example.net" },
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:      "user not verified",
+			requireUV: true,
+			modify:    func(a *webauthntest.Authenticator) { a.UserVerified = false },
+			want:      codes.PermissionDenied,
+		},
+		{
+			name: "challenge already used",
+			finish: func(mock sqlmock.Sqlmock, challenge []byte) {
+				mock.ExpectBegin()
+				expectChallengeConsumed(mock, challenge, webauthn.TypeCreate, 0)
+				mock.ExpectRollback()
+			},
+			want: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			h, mock := newPasskeyHandler(t)
+			h.WebAuthn.RequireUserVerification = tt.requireUV
+			a, err := webauthntest.New(testRPID, testOrigin)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			mock.ExpectQuery(`FROM "users"`).WillReturnRows(passkeyUserRows())
+			mock.ExpectQuery(`FROM "passkey_credentials"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			expectInsert(mock, "webauthn_challenges")
+			options, err := h.BeginPasskeyRegistration(ctx, &pb.BeginPasskeyRegistrationRequest{UserId: passkeyUserID})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.modify != nil {
+				tt.modify(a)
+			}
+			clientDataJSON, attestation, err := a.Register(options.Challenge)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			mock.ExpectQuery(`FROM "users"`).WillReturnRows(passkeyUserRows())
+			if tt.finish != nil {
+				tt.finish(mock, options.Challenge)
+			}
+			passkey, err := h.FinishPasskeyRegistration(ctx, &pb.FinishPasskeyRegistrationRequest{
+				UserId:            passkeyUserID,
+				Name:              "Laptop",
+				ClientDataJSON:    clientDataJSON,
+				AttestationObject: attestation,
+			})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("got %v, want %v (%v)", got, tt.want, err)
+			}
+			if err == nil && passkey.CredentialId != encodeBinary(a.CredentialID) {
+				t.Errorf("got credential %s, want %s", passkey.CredentialId, encodeBinary(a.CredentialID))
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func TestPasskeyLogin(t *testing.T) {
+	tests := []struct {
+		name string
+		// Sign count the relying party has stored for the credential
+		storedCount uint32
+		modify      func(a *webauthntest.Authenticator)
+		// Statements run once the assertion has been verified
+		finish func(mock sqlmock.Sqlmock, challenge []byte, storedCount uint32)
+		want   codes.Code
+	}{
+		{
+			name: "signs in",
+			finish: func(mock sqlmock.Sqlmock, challenge []byte, storedCount uint32) {
+				mock.ExpectBegin()
+				expectChallengeConsumed(mock, challenge, webauthn.TypeGet, 1)
+				mock.ExpectExec(`UPDATE "passkey_credentials" SET`).
+					WithArgs(sqlmock.AnyArg(), int64(storedCount+1), passkeyID, int64(storedCount)).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				mock.ExpectCommit()
+				expectInsert(mock, "sessions")
+				expectInsert(mock, "refresh_tokens")
+			},
+			want: codes.OK,
+		},
+		{
+			name:        "sign count went backwards",
+			storedCount: 10,
+			modify:      func(a *webauthntest.Authenticator) { a.SignCount = 3 },
+			want:        codes.PermissionDenied,
+		},
+		{
+			name: "sign count raced by a cloned authenticator",
+			finish: func(mock sqlmock.Sqlmock, challenge []byte, storedCount uint32) {
+				mock.ExpectBegin()
+				expectChallengeConsumed(mock, challenge, webauthn.TypeGet, 1)
+				mock.ExpectExec(`UPDATE "passkey_credentials" SET`).WillReturnResult(sqlmock.NewResult(0, 0))
+				mock.ExpectRollback()
+			},
+			want: codes.PermissionDenied,
+		},
+		{
+			name:   "user not verified",
+			modify: func(a *webauthntest.Authenticator) { a.UserVerified = false },
+			want:   codes.PermissionDenied,
+		},
+		{
+			name:   "wrong origin",
+			modify: func(a *webauthntest.Authenticator) { a.Origin = "https://example.net" },
+			want:   codes.PermissionDenied,
+		},
+		{
+			name: "challenge already used",
+			finish: func(mock sqlmock.Sqlmock, challenge []byte, storedCount uint32) {
+				mock.ExpectBegin()
+				expectChallengeConsumed(mock, challenge, webauthn.TypeGet, 0)
+				mock.ExpectRollback()
+			},
+			want: codes.PermissionDenied,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			h, mock := newPasskeyHandler(t)
+			a, err := webauthntest.New(testRPID, testOrigin)
+			if err != nil {
+				t.Fatal(err)
+			}
+			credential := register(t, h.WebAuthn, a)
+			a.SignCount = tt.storedCount
+
+			passkeyRows := func() *sqlmock.Rows {
+				return sqlmock.NewRows([]string{"id", "user_id", "credential_id", "public_key", "sign_count"}).
+					AddRow(passkeyID, passkeyUserID, encodeBinary(credential.ID), encodeBinary(credential.PublicKey), int64(tt.storedCount))
+			}
+
+			mock.ExpectQuery(`FROM "users"`).WillReturnRows(passkeyUserRows())
+			mock.ExpectQuery(`FROM "passkey_credentials"`).WillReturnRows(passkeyRows())
+			expectInsert(mock, "webauthn_challenges")
+			options, err := h.BeginPasskeyLogin(ctx, &pb.BeginPasskeyLoginRequest{LoginId: "ada@example.com"})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if tt.modify != nil {
+				tt.modify(a)
+			}
+			assertion, err := a.Assert(options.Challenge)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			mock.ExpectQuery(`FROM "passkey_credentials"`).WillReturnRows(passkeyRows())
+			mock.ExpectQuery(`FROM "users"`).WillReturnRows(passkeyUserRows())
+			if tt.finish != nil {
+				tt.finish(mock, options.Challenge, tt.storedCount)
+			}
+			res, err := h.FinishPasskeyLogin(ctx, &pb.FinishPasskeyLoginRequest{
+				CredentialId:      a.CredentialID,
+				ClientDataJSON:    assertion.ClientDataJSON,
+				AuthenticatorData: assertion.AuthenticatorData,
+				Signature:         assertion.Signature,
+				UserHandle:        []byte(passkeyUserID),
+			})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("got %v, want %v (%v)", got, tt.want, err)
+			}
+			if err == nil && res.Token == "" {
+				t.Error("no access token was issued")
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,108 @@
+package routes
+
+import (
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lerryjay/auth-grpc-service/pkg/password"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// checkPassword validates a new password for the user before it is hashed.
+// Every broken rule is returned as a field violation on the request field
+// the password came in on.
+func (h *Handler) checkPassword(field string, user *models.UserORM, newPassword string) error {
+	if newPassword == "" {
+		return status.Errorf(codes.InvalidArgument, "%s is required", field)
+	}
+	if h.PasswordPolicy == nil {
+		return nil
+	}
+
+	violations := h.PasswordPolicy.Check(newPassword, user.Username, user.Email)
+	if user.Id != "" && h.reusesPassword(user, newPassword) {
+		violations = append(violations, password.Violation{
+			Rule:    password.RuleHistory,
+			Message: fmt.Sprintf("must not match any of your last %d passwords", h.PasswordPolicy.HistorySize),
+		})
+	}
+	if len(violations) == 0 {
+		return nil
+	}
+
+	badRequest := &errdetails.BadRequest{}
+	for _, v := range violations {
+		badRequest.FieldViolations = append(badRequest.FieldViolations, &errdetails.BadRequest_FieldViolation{
+			Field:       field,
+			Description: v.Rule + ": " + v.Message,
+		})
+	}
+
+	st := status.New(codes.InvalidArgument, "Password does not meet the password policy")
+	detailed, err := st.WithDetails(badRequest)
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// reusesPassword reports whether the password matches the current password or
+// one of the ones kept in the user's password history
+func (h *Handler) reusesPassword(user *models.UserORM, newPassword string) bool {
+	if h.PasswordPolicy.HistorySize <= 0 {
+		return false
+	}
+	if user.Password != "" && h.Hasher.Verify(user.Password, newPassword) {
+		return true
+	}
+
+	var history []models.PasswordHistoryORM
+	err := h.DB.Where("user_id = ?", user.Id).
+		Order("created_at DESC").
+		Limit(h.PasswordPolicy.HistorySize).
+		Find(&history).Error
+	if err != nil {
+		log.Println("Error reading password history", err)
+		return false
+	}
+
+	for _, entry := range history {
+		if h.Hasher.Verify(entry.PasswordHash, newPassword) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordPasswordHistory remembers a password hash the user has had and
+// drops entries older than the configured history size
+func (h *Handler) recordPasswordHistory(db *gorm.DB, userID, hash string) error {
+	if h.PasswordPolicy == nil || h.PasswordPolicy.HistorySize <= 0 || hash == "" {
+		return nil
+	}
+
+	now := time.Now()
+	entry := models.PasswordHistoryORM{
+		Id:           uuid.New().String(),
+		UserId:       &userID,
+		PasswordHash: hash,
+		CreatedAt:    &now,
+	}
+	if err := db.Create(&entry).Error; err != nil {
+		return err
+	}
+
+	keep := db.Model(&models.PasswordHistoryORM{}).
+		Select("id").
+		Where("user_id = ?", userID).
+		Order("created_at DESC").
+		Limit(h.PasswordPolicy.HistorySize)
+	return db.Where("user_id = ? AND id NOT IN (?)", userID, keep).
+		Delete(&models.PasswordHistoryORM{}).Error
+}
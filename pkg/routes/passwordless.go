@@ -0,0 +1,199 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lerryjay/auth-grpc-service/pkg/notify"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"github.com/lerryjay/auth-grpc-service/pkg/throttle"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// magicLink builds the link the user follows to complete a passwordless login
+func (h *Handler) magicLink(token string) (string, error) {
+	link, err := url.Parse(h.PasswordlessLinkURL)
+	if err != nil {
+		return "", err
+	}
+	query := link.Query()
+	query.Set("token", token)
+	link.RawQuery = query.Encode()
+	return link.String(), nil
+}
+
+// maskLoginID masks a login id that is an email address or phone number.
+// Usernames aren't an address the code can be sent to, so nothing is shown.
+func maskLoginID(loginID string) string {
+	if strings.Contains(loginID, "@") {
+		return notify.MaskEmail(loginID)
+	}
+	digits := strings.TrimPrefix(loginID, "+")
+	if digits == "" {
+		return ""
+	}
+	for _, r := range digits {
+		if r < '0' || r > '9' {
+			return ""
+		}
+	}
+	return notify.MaskPhone(loginID)
+}
+
+// loginAddress returns the user's email or phone number when loginID is one
+// of them, so messages go to the address the response shows. Otherwise both
+// are returned.
+func loginAddress(user *models.UserORM, loginID string) (email, telephone string) {
+	email, telephone = user.Email, user.Telephone
+	if loginID == user.Telephone {
+		email = ""
+	} else if loginID == user.Email {
+		telephone = ""
+	}
+	return email, telephone
+}
+
+func (h *Handler) StartPasswordlessLogin(ctx context.Context, req *pb.StartPasswordlessLoginRequest) (*pb.StartPasswordlessLoginResponse, error) {
+	switch req.Method {
+	case pb.PasswordlessMethod_CODE:
+	case pb.PasswordlessMethod_MAGIC_LINK:
+		if h.PasswordlessLinkURL == "" {
+			return nil, status.Errorf(codes.FailedPrecondition, "Sign in links are not enabled")
+		}
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "Unsupported sign in method")
+	}
+
+	// Unknown login ids get the same response, so this can't be used to
+	// find out which accounts exist
+	expiresAt := time.Now().Add(h.OTPTTL)
+	res := &pb.StartPasswordlessLoginResponse{
+		Destination: maskLoginID(req.LoginId),
+		ExpiresAt:   expiresAt.Format(time.RFC3339),
+	}
+
+	var user models.UserORM
+	query := h.DB.First(&user, "email = ? OR username = ? OR telephone = ?", req.LoginId, req.LoginId, req.LoginId)
+	accountID := user.Id
+	if query.Error != nil {
+		accountID = strings.ToLower(strings.TrimSpace(req.LoginId))
+	}
+	if err := h.checkSendThrottle(ctx, accountID); err != nil {
+		return nil, err
+	}
+	if query.Error != nil {
+		log.Println("Passwordless login requested for unknown login id", query.Error)
+		return res, nil
+	}
+
+	data := map[string]interface{}{
+		"FirstName":        user.Firstname,
+		"ExpiresInMinutes": int(h.OTPTTL.Minutes()),
+	}
+
+	switch req.Method {
+	case pb.PasswordlessMethod_CODE:
+		code, _, err := h.issueOTP(h.DB, user.Id, models.OtpPurpose_PASSWORDLESS_LOGIN)
+		if err != nil {
+			log.Println("Error creating sign in code", err)
+			return nil, status.Errorf(codes.Internal, "Unable to send sign in code")
+		}
+		data["Code"] = code
+	case pb.PasswordlessMethod_MAGIC_LINK:
+		// The nonce is stored like a code, which makes the link single use
+		nonce := uuid.New().String()
+		_, err := h.storeOTP(h.DB, user.Id, models.OtpPurpose_PASSWORDLESS_LOGIN, nonce)
+		if err != nil {
+			log.Println("Error creating sign in link", err)
+			return nil, status.Errorf(codes.Internal, "Unable to send sign in link")
+		}
+		token, err := h.JWT.GenerateMagicLinkToken(user.Id, nonce, h.OTPTTL)
+		if err != nil {
+			log.Println("Error signing sign in link", err)
+			return nil, status.Errorf(codes.Internal, "Unable to send sign in link")
+		}
+		link, err := h.magicLink(token)
+		if err != nil {
+			log.Println("Invalid PASSWORDLESS_LINK_URL", err)
+			return nil, status.Errorf(codes.Internal, "Unable to send sign in link")
+		}
+		data["Link"] = link
+	}
+
+	email, telephone := loginAddress(&user, req.LoginId)
+	if _, err := h.Notifier.SendToUser(ctx, email, telephone, "passwordless_login", data); err != nil {
+		log.Println("Error sending passwordless login", err)
+		return nil, status.Errorf(codes.Internal, "Unable to send sign in code")
+	}
+
+	return res, nil
+}
+
+func (h *Handler) CompletePasswordlessLogin(ctx context.Context, req *pb.CompletePasswordlessLoginRequest) (*pb.LoginUserResponse, error) {
+
+	var user models.UserORM
+	var code string
+	var accountID string
+	if req.Token != "" {
+		claims, err := h.JWT.VerifyMagicLinkToken(req.Token)
+		if err != nil {
+			log.Println("Invalid sign in link", err)
+			return nil, status.Errorf(codes.PermissionDenied,
+				"Invalid or expired sign in link")
+		}
+		if query := h.DB.First(&user, "id = ?", claims.Subject); query.Error != nil {
+			log.Println(query.Error)
+			return nil, status.Errorf(codes.PermissionDenied,
+				"Invalid or expired sign in link")
+		}
+		code = claims.ID
+	} else {
+		query := h.DB.First(&user, "email = ? OR username = ? OR telephone = ?", req.LoginId, req.LoginId, req.LoginId)
+		if query.Error != nil {
+			log.Println(query.Error)
+			accountID = strings.ToLower(strings.TrimSpace(req.LoginId))
+		}
+		code = req.Code
+	}
+
+	if user.Id != "" {
+		accountID = user.Id
+	}
+	accountKey := throttle.AccountKey(throttleOTP, accountID)
+	keys := throttleKeys(ctx, throttleOTP, accountKey)
+	if err := h.checkThrottle(ctx, keys...); err != nil {
+		return nil, err
+	}
+
+	// Unknown login ids fail like a wrong code, so this can't be used to
+	// find out which accounts exist
+	if user.Id == "" {
+		h.recordFailure(ctx, keys...)
+		return nil, otpError(errOTPInvalid)
+	}
+
+	err := h.DB.Transaction(func(tx *gorm.DB) error {
+		return h.checkOTP(tx, user.Id, models.OtpPurpose_PASSWORDLESS_LOGIN, code, true)
+	})
+	if err != nil {
+		log.Println("Error completing passwordless login", err)
+		if isOTPFailure(err) {
+			h.recordFailure(ctx, keys...)
+		}
+		return nil, otpError(err)
+	}
+	h.clearFailures(ctx, accountKey)
+
+	if !h.loginVerified(&user) {
+		return nil, notVerifiedError(&user)
+	}
+
+	return h.completeLogin(ctx, &user)
+}
@@ -0,0 +1,94 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const recoveryCodeCount = 10
+
+// replaceRecoveryCodes invalidates the user's recovery codes and stores a new
+// set, returning the plaintext codes to show to the user once
+func replaceRecoveryCodes(db *gorm.DB, userID string) ([]string, error) {
+	plaintext := make([]string, 0, recoveryCodeCount)
+	records := make([]models.RecoveryCodeORM, 0, recoveryCodeCount)
+	now := time.Now()
+
+	for i := 0; i < recoveryCodeCount; i++ {
+		code, err := helpers.GenerateRecoveryCode()
+		if err != nil {
+			return nil, err
+		}
+		plaintext = append(plaintext, code)
+		records = append(records, models.RecoveryCodeORM{
+			Id:        uuid.New().String(),
+			UserId:    &userID,
+			CodeHash:  helpers.HashToken(helpers.NormalizeRecoveryCode(code)),
+			CreatedAt: &now,
+		})
+	}
+
+	err := db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", userID).Delete(&models.RecoveryCodeORM{}).Error; err != nil {
+			return err
+		}
+		return tx.Create(&records).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return plaintext, nil
+}
+
+// useRecoveryCode consumes one of the user's unused recovery codes
+func (h *Handler) useRecoveryCode(userID, code string) error {
+	hash := helpers.HashToken(helpers.NormalizeRecoveryCode(code))
+
+	result := h.DB.Model(&models.RecoveryCodeORM{}).
+		Where("user_id = ? AND code_hash = ? AND used_at IS NULL", userID, hash).
+		Update("used_at", time.Now())
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errInvalidSecondFactor
+	}
+
+	return nil
+}
+
+func (h *Handler) remainingRecoveryCodes(userID string) int32 {
+	var count int64
+	query := h.DB.Model(&models.RecoveryCodeORM{}).Where("user_id = ? AND used_at IS NULL", userID).Count(&count)
+	if query.Error != nil {
+		log.Println(query.Error)
+	}
+	return int32(count)
+}
+
+func (h *Handler) RegenerateRecoveryCodes(ctx context.Context, req *pb.RegenerateRecoveryCodesRequest) (*pb.RecoveryCodesResponse, error) {
+	err := h.checkSecondFactor(ctx, req.UserId, func() error {
+		return h.verifyTOTP(req.UserId, req.Code)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	recoveryCodes, err := replaceRecoveryCodes(h.DB, req.UserId)
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "Unable to generate recovery codes")
+	}
+
+	return &pb.RecoveryCodesResponse{RecoveryCodes: recoveryCodes}, nil
+}
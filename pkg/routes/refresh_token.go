@@ -0,0 +1,156 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+var errRefreshTokenReused = errors.New("refresh token reused")
+
+// issueTokens signs an access token for the user and stores a new refresh
+// token for sessionID. An empty sessionID starts a new session, which
+// happens on every fresh login.
+func (h *Handler) issueTokens(ctx context.Context, db *gorm.DB, user *models.UserORM, sessionID string) (*pb.LoginUserResponse, error) {
+	if sessionID == "" {
+		session, err := h.createSession(ctx, db, user.Id)
+		if err != nil {
+			return nil, err
+		}
+		sessionID = session.Id
+	}
+
+	subject := helpers.TokenSubject{
+		UserId:    user.Id,
+		Role:      user.Role,
+		SessionId: sessionID,
+	}
+	if h.PermissionClaims {
+		authorization, err := tokenAuthorization(db, user.Id)
+		if err != nil {
+			return nil, err
+		}
+		subject.Authorization = authorization
+	}
+
+	accessToken, err := h.JWT.GenerateToken(subject)
+	if err != nil {
+		return nil, err
+	}
+
+	refreshToken, err := helpers.GenerateOpaqueToken(32)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	expiresAt := now.Add(h.RefreshTokenTTL)
+	record := models.RefreshTokenORM{
+		Id:        uuid.New().String(),
+		UserId:    &user.Id,
+		TokenHash: helpers.HashToken(refreshToken),
+		FamilyId:  sessionID,
+		ExpiresAt: &expiresAt,
+		CreatedAt: &now,
+	}
+	if err := db.Create(&record).Error; err != nil {
+		return nil, err
+	}
+
+	return &pb.LoginUserResponse{
+		Token:        accessToken,
+		RefreshToken: refreshToken,
+		ExpiresIn:    int64(h.JWT.AccessTokenTTL().Seconds()),
+	}, nil
+}
+
+// revokeTokenFamily ends the session a leaked refresh token was issued for,
+// invalidating every refresh token descended from the same login
+func (h *Handler) revokeTokenFamily(token *models.RefreshTokenORM) {
+	if token.UserId == nil {
+		return
+	}
+	if err := h.revokeSessions(h.DB, *token.UserId, token.FamilyId, ""); err != nil {
+		log.Println("Error revoking refresh token family", token.FamilyId, err)
+	}
+}
+
+func (h *Handler) RefreshToken(ctx context.Context, req *pb.RefreshTokenRequest) (*pb.LoginUserResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Refresh token is required")
+	}
+
+	var token models.RefreshTokenORM
+	query := h.DB.First(&token, "token_hash = ?", helpers.HashToken(req.RefreshToken))
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid refresh token")
+	}
+
+	// A used token being presented again means it has leaked, so everything
+	// issued from the same login is revoked
+	if token.UsedAt != nil {
+		log.Println("Refresh token reuse detected for family", token.FamilyId)
+		h.revokeTokenFamily(&token)
+		return nil, status.Errorf(codes.Unauthenticated, "Refresh token has already been used")
+	}
+	if token.RevokedAt != nil {
+		return nil, helpers.TokenStatusError(helpers.ErrSessionRevoked)
+	}
+
+	now := time.Now()
+	if token.ExpiresAt == nil || now.After(*token.ExpiresAt) {
+		return nil, status.Errorf(codes.Unauthenticated, "Refresh token has expired")
+	}
+
+	var user models.UserORM
+	query = h.DB.First(&user, "id = ?", token.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid refresh token")
+	}
+
+	var response *pb.LoginUserResponse
+	err := h.DB.Transaction(func(tx *gorm.DB) error {
+		// Only the first of two concurrent refreshes wins the update
+		result := tx.Model(&models.RefreshTokenORM{}).
+			Where("id = ? AND used_at IS NULL AND revoked_at IS NULL", token.Id).
+			Update("used_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return errRefreshTokenReused
+		}
+
+		result = tx.Model(&models.SessionORM{}).Where("id = ?", token.FamilyId).Update("last_seen_at", now)
+		if result.Error != nil {
+			return result.Error
+		}
+
+		var err error
+		response, err = h.issueTokens(ctx, tx, &user, token.FamilyId)
+		return err
+	})
+	if errors.Is(err, errRefreshTokenReused) {
+		log.Println("Refresh token reuse detected for family", token.FamilyId)
+		h.revokeTokenFamily(&token)
+		return nil, status.Errorf(codes.Unauthenticated, "Refresh token has already been used")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "Error refreshing token")
+	}
+
+	response.Message = "Token refreshed"
+	return response, nil
+}
@@ -0,0 +1,133 @@
+package routes
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRefreshTokenRotation(t *testing.T) {
+	const (
+		userID    = "6f1c3b8e-4a8e-4c52-9a43-0d3f9a1e7b21"
+		tokenID   = "2c7e9a41-5d3b-4f6e-8a1c-9b0d4e7f2a63"
+		sessionID = "8e4b1f7a-2c9d-4a3e-b5f6-1d0c7a9e3b52"
+		presented = "presented-refresh-token"
+	)
+	future := time.Now().Add(time.Hour)
+	past := time.Now().Add(-time.Hour)
+
+	stored := func(usedAt, revokedAt *time.Time, expiresAt time.Time) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "user_id", "token_hash", "family_id", "expires_at", "used_at", "revoked_at"}).
+			AddRow(tokenID, userID, helpers.HashToken(presented), sessionID, expiresAt, usedAt, revokedAt)
+	}
+	userRows := func() *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "email"}).AddRow(userID, "ada@example.com")
+	}
+	markUsed := func(mock sqlmock.Sqlmock, found int64) {
+		mock.ExpectExec(`UPDATE "refresh_tokens" SET "used_at"=\$1 WHERE id = \$2 AND used_at IS NULL AND revoked_at IS NULL`).
+			WithArgs(sqlmock.AnyArg(), tokenID).
+			WillReturnResult(sqlmock.NewResult(0, found))
+	}
+	// Reuse revokes the session and every refresh token issued for it
+	revokeFamily := func(mock sqlmock.Sqlmock) {
+		mock.ExpectBegin()
+		mock.ExpectExec(`UPDATE "sessions" SET "revoked_at"=\$1 WHERE \(user_id = \$2 AND revoked_at IS NULL\) AND id = \$3`).
+			WithArgs(sqlmock.AnyArg(), userID, sessionID).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		mock.ExpectExec(`UPDATE "refresh_tokens" SET "revoked_at"=\$1 WHERE \(user_id = \$2 AND revoked_at IS NULL\) AND family_id = \$3`).
+			WithArgs(sqlmock.AnyArg(), userID, sessionID).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+		mock.ExpectCommit()
+	}
+
+	tests := []struct {
+		name   string
+		expect func(mock sqlmock.Sqlmock)
+		want   codes.Code
+	}{
+		{
+			name: "rotates the token",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM "refresh_tokens"`).WillReturnRows(stored(nil, nil, future))
+				mock.ExpectQuery(`FROM "users"`).WillReturnRows(userRows())
+				mock.ExpectBegin()
+				markUsed(mock, 1)
+				mock.ExpectExec(`UPDATE "sessions" SET "last_seen_at"`).
+					WithArgs(sqlmock.AnyArg(), sessionID).
+					WillReturnResult(sqlmock.NewResult(0, 1))
+				// The new token stays in the same family
+				mock.ExpectQuery(`INSERT INTO "refresh_tokens"`).
+					WithArgs(sqlmock.AnyArg(), sqlmock.AnyArg(), sessionID, nil, sqlmock.AnyArg(), nil, userID, sqlmock.AnyArg()).
+					WillReturnRows(sqlmock.NewRows([]string{"id"}))
+				mock.ExpectCommit()
+			},
+			want: codes.OK,
+		},
+		{
+			name: "used token is replayed",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM "refresh_tokens"`).WillReturnRows(stored(&past, nil, future))
+				revokeFamily(mock)
+			},
+			want: codes.Unauthenticated,
+		},
+		{
+			name: "token used by a concurrent refresh",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM "refresh_tokens"`).WillReturnRows(stored(nil, nil, future))
+				mock.ExpectQuery(`FROM "users"`).WillReturnRows(userRows())
+				mock.ExpectBegin()
+				markUsed(mock, 0)
+				mock.ExpectRollback()
+				revokeFamily(mock)
+			},
+			want: codes.Unauthenticated,
+		},
+		{
+			name: "revoked token",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM "refresh_tokens"`).WillReturnRows(stored(nil, &past, future))
+			},
+			want: codes.Unauthenticated,
+		},
+		{
+			name: "expired token",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM "refresh_tokens"`).WillReturnRows(stored(nil, nil, past))
+			},
+			want: codes.Unauthenticated,
+		},
+		{
+			name: "unknown token",
+			expect: func(mock sqlmock.Sqlmock) {
+				mock.ExpectQuery(`FROM "refresh_tokens"`).WillReturnRows(sqlmock.NewRows([]string{"id"}))
+			},
+			want: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h, mock, _ := newTestHandler(t)
+			issueTestTokens(t, h)
+			tt.expect(mock)
+
+			res, err := h.RefreshToken(context.Background(), &pb.RefreshTokenRequest{RefreshToken: presented})
+			if got := status.Code(err); got != tt.want {
+				t.Errorf("got %v, want %v (%v)", got, tt.want, err)
+			}
+			if err == nil && (res.Token == "" || res.RefreshToken == "" || res.RefreshToken == presented) {
+				t.Errorf("got access token %q and refresh token %q, want new ones", res.Token, res.RefreshToken)
+			}
+			if err := mock.ExpectationsWereMet(); err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
@@ -0,0 +1,402 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"github.com/lerryjay/auth-grpc-service/pkg/rbac"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+// userGrants loads the permissions granted to the user directly and, when
+// includeRoles is set, through their roles
+func userGrants(db *gorm.DB, userID string, includeRoles bool) ([]rbac.Grant, error) {
+	var grants []rbac.Grant
+	err := db.Model(&models.UserPermissionORM{}).
+		Select("permission, resource_type, resource_id").
+		Where("user_id = ? AND status = ?", userID, int32(models.Status_ACTIVE)).
+		Scan(&grants).Error
+	if err != nil || !includeRoles {
+		return grants, err
+	}
+
+	var fromRoles []rbac.Grant
+	err = db.Model(&models.PermissionORM{}).
+		Select("permissions.name AS permission, user_roles.resource_type, user_roles.resource_id").
+		Joins("JOIN role_permissions ON role_permissions.permission_orm_id = permissions.id").
+		Joins("JOIN user_roles ON user_roles.role_id = role_permissions.role_orm_id").
+		Where("user_roles.user_id = ?", userID).
+		Scan(&fromRoles).Error
+	return append(grants, fromRoles...), err
+}
+
+// userPermissions returns the names of the user's global permissions
+func userPermissions(db *gorm.DB, userID string, includeRoles bool) ([]string, error) {
+	grants, err := userGrants(db, userID, includeRoles)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var permissions []string
+	for _, grant := range grants {
+		if grant.ResourceType == "" && !seen[grant.Permission] {
+			seen[grant.Permission] = true
+			permissions = append(permissions, grant.Permission)
+		}
+	}
+	sort.Strings(permissions)
+	return permissions, nil
+}
+
+// checkGrant makes sure a permission granted directly to a user is, or
+// matches, an entry in the catalogue
+func checkGrant(db *gorm.DB, permission, resourceType, resourceID string) error {
+	if err := rbac.ValidatePermissionName(permission); err != nil {
+		return status.Errorf(codes.InvalidArgument, "Invalid permission %s", permission)
+	}
+	if resourceID != "" && resourceType == "" {
+		return status.Errorf(codes.InvalidArgument, "ResourceType is required with a ResourceId")
+	}
+
+	var catalogue []string
+	if err := db.Model(&models.PermissionORM{}).Pluck("name", &catalogue).Error; err != nil {
+		log.Println(err)
+		return status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+	for _, name := range catalogue {
+		if rbac.Match(name, permission) || rbac.Match(permission, name) {
+			return nil
+		}
+	}
+	return status.Errorf(codes.InvalidArgument, "Unknown permission %s", permission)
+}
+
+// findPermissions loads the named permissions from the catalogue
+func findPermissions(db *gorm.DB, names []string) ([]*models.PermissionORM, error) {
+	permissions := []*models.PermissionORM{}
+	if len(names) == 0 {
+		return permissions, nil
+	}
+	if err := db.Where("name IN ?", names).Find(&permissions).Error; err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	found := make(map[string]bool)
+	for _, permission := range permissions {
+		found[permission.Name] = true
+	}
+	for _, name := range names {
+		if !found[name] {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown permission %s", name)
+		}
+	}
+	return permissions, nil
+}
+
+// assignRole gives the user a role
+func assignRole(tx *gorm.DB, userID, roleID string) error {
+	now := time.Now()
+	return tx.Create(&models.UserRoleORM{
+		Id:        uuid.New().String(),
+		UserId:    &userID,
+		RoleId:    &roleID,
+		CreatedAt: &now,
+	}).Error
+}
+
+// assignNamedRole gives the user the named role. An empty name assigns nothing.
+func assignNamedRole(tx *gorm.DB, userID, roleName string) error {
+	if roleName == "" {
+		return nil
+	}
+	var role models.RoleORM
+	if err := tx.First(&role, "name = ?", roleName).Error; err != nil {
+		return err
+	}
+	return assignRole(tx, userID, role.Id)
+}
+
+func (h *Handler) ListPermissions(ctx context.Context, req *emptypb.Empty) (*pb.ListPermissionsResponse, error) {
+	var permissions []models.PermissionORM
+	if err := h.DB.Order("name").Find(&permissions).Error; err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	res := &pb.ListPermissionsResponse{}
+	for _, obj := range permissions {
+		permission, err := obj.ToPB(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"Could not convert permission %s", err)
+		}
+		res.Permissions = append(res.Permissions, &permission)
+	}
+
+	return res, nil
+}
+
+func (h *Handler) CreateRole(ctx context.Context, req *pb.CreateRoleRequest) (*models.Role, error) {
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Role name is required")
+	}
+
+	var count int64
+	h.DB.Model(&models.RoleORM{}).Where("name = ?", name).Count(&count)
+	if count > 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "A role with this name already exists")
+	}
+
+	permissions, err := findPermissions(h.DB, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	role := models.RoleORM{
+		Id:          uuid.New().String(),
+		Name:        name,
+		Description: req.Description,
+		Permissions: permissions,
+		CreatedAt:   &now,
+		UpdatedAt:   &now,
+	}
+	if err := h.DB.Create(&role).Error; err != nil {
+		log.Println("Error creating role", err)
+		return nil, status.Errorf(codes.Internal, "Unable to create role")
+	}
+
+	res, _ := role.ToPB(ctx)
+	return &res, nil
+}
+
+func (h *Handler) GetRole(ctx context.Context, req *pb.GetRoleRequest) (*models.Role, error) {
+	var role models.RoleORM
+	query := h.DB.Preload("Permissions").First(&role, "id = ?", req.Id)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "Role not found")
+	}
+
+	res, _ := role.ToPB(ctx)
+	return &res, nil
+}
+
+func (h *Handler) ListRoles(ctx context.Context, req *emptypb.Empty) (*pb.ListRolesResponse, error) {
+	var roles []models.RoleORM
+	if err := h.DB.Preload("Permissions").Order("name").Find(&roles).Error; err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	return rolesResponse(ctx, roles)
+}
+
+func (h *Handler) UpdateRole(ctx context.Context, req *pb.UpdateRoleRequest) (*models.Role, error) {
+	var role models.RoleORM
+	query := h.DB.First(&role, "id = ?", req.Id)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "Role not found")
+	}
+	if role.System {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Built in roles can only be changed in the RBAC config")
+	}
+
+	name := strings.TrimSpace(req.Name)
+	if name == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Role name is required")
+	}
+	if name != role.Name {
+		var count int64
+		h.DB.Model(&models.RoleORM{}).Where("name = ?", name).Count(&count)
+		if count > 0 {
+			return nil, status.Errorf(codes.AlreadyExists, "A role with this name already exists")
+		}
+	}
+
+	permissions, err := findPermissions(h.DB, req.Permissions)
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		err := tx.Model(&role).Updates(map[string]interface{}{
+			"name":        name,
+			"description": req.Description,
+			"updated_at":  time.Now(),
+		}).Error
+		if err != nil {
+			return err
+		}
+		if err := tx.Model(&role).Association("Permissions").Replace(permissions); err != nil {
+			return err
+		}
+		return rbac.BumpVersion(tx, rbac.RoleHolders(tx, role.Id))
+	})
+	if err != nil {
+		log.Println("Error updating role", err)
+		return nil, status.Errorf(codes.Internal, "Unable to update role")
+	}
+
+	return h.GetRole(ctx, &pb.GetRoleRequest{Id: role.Id})
+}
+
+func (h *Handler) DeleteRole(ctx context.Context, req *pb.DeleteRoleRequest) (*emptypb.Empty, error) {
+	var role models.RoleORM
+	query := h.DB.First(&role, "id = ?", req.Id)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "Role not found")
+	}
+	if role.System {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Built in roles can only be removed from the RBAC config")
+	}
+
+	var assigned int64
+	h.DB.Model(&models.UserRoleORM{}).Where("role_id = ?", role.Id).Count(&assigned)
+	if assigned > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Remove this role from its %d users before deleting it", assigned)
+	}
+
+	err := h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&role).Association("Permissions").Clear(); err != nil {
+			return err
+		}
+		return tx.Delete(&role).Error
+	})
+	if err != nil {
+		log.Println("Error deleting role", err)
+		return nil, status.Errorf(codes.Internal, "Unable to delete role")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) AssignUserRole(ctx context.Context, req *pb.UserRoleRequest) (*emptypb.Empty, error) {
+	if req.ResourceId != "" && req.ResourceType == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "ResourceType is required with a ResourceId")
+	}
+
+	var user models.UserORM
+	query := h.DB.First(&user, "id = ?", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "User not found")
+	}
+
+	var role models.RoleORM
+	query = h.DB.First(&role, "id = ?", req.RoleId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "Role not found")
+	}
+
+	var count int64
+	h.DB.Model(&models.UserRoleORM{}).
+		Where("user_id = ? AND role_id = ? AND resource_type = ? AND resource_id = ?", user.Id, role.Id, req.ResourceType, req.ResourceId).
+		Count(&count)
+	if count > 0 {
+		return nil, status.Errorf(codes.AlreadyExists, "User already has this role")
+	}
+
+	now := time.Now()
+	assignment := models.UserRoleORM{
+		Id:           uuid.New().String(),
+		UserId:       &user.Id,
+		RoleId:       &role.Id,
+		ResourceType: req.ResourceType,
+		ResourceId:   req.ResourceId,
+		CreatedAt:    &now,
+	}
+	err := h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&assignment).Error; err != nil {
+			return err
+		}
+		return rbac.BumpVersion(tx, []string{user.Id})
+	})
+	if err != nil {
+		log.Println("Error assigning role", err)
+		return nil, status.Errorf(codes.Internal, "Unable to assign role")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) RemoveUserRole(ctx context.Context, req *pb.UserRoleRequest) (*emptypb.Empty, error) {
+	errNotAssigned := errors.New("role not assigned")
+	err := h.DB.Transaction(func(tx *gorm.DB) error {
+		query := tx.
+			Where("user_id = ? AND role_id = ? AND resource_type = ? AND resource_id = ?", req.UserId, req.RoleId, req.ResourceType, req.ResourceId).
+			Delete(&models.UserRoleORM{})
+		if query.Error != nil {
+			return query.Error
+		}
+		if query.RowsAffected == 0 {
+			return errNotAssigned
+		}
+		return rbac.BumpVersion(tx, []string{req.UserId})
+	})
+	if errors.Is(err, errNotAssigned) {
+		return nil, status.Errorf(codes.NotFound, "User does not have this role")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "Unable to remove role")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) ListUserRoles(ctx context.Context, req *pb.ListUserRolesRequest) (*pb.ListUserRolesResponse, error) {
+	var assignments []models.UserRoleORM
+	query := h.DB.Preload("Role.Permissions").
+		Where("user_id = ?", req.UserId).
+		Order("created_at").
+		Find(&assignments)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	res := &pb.ListUserRolesResponse{}
+	for _, obj := range assignments {
+		assignment, err := obj.ToPB(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"Could not convert role %s", err)
+		}
+		res.Roles = append(res.Roles, &assignment)
+	}
+
+	return res, nil
+}
+
+func rolesResponse(ctx context.Context, roles []models.RoleORM) (*pb.ListRolesResponse, error) {
+	res := &pb.ListRolesResponse{}
+	for _, obj := range roles {
+		role, err := obj.ToPB(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"Could not convert role %s", err)
+		}
+		res.Roles = append(res.Roles, &role)
+	}
+	return res, nil
+}
@@ -2,10 +2,17 @@ package routes
 
 import (
 	"log"
+	"time"
 
 	"gorm.io/gorm"
 
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/identity"
+	"github.com/lerryjay/auth-grpc-service/pkg/notify"
+	"github.com/lerryjay/auth-grpc-service/pkg/password"
 	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"github.com/lerryjay/auth-grpc-service/pkg/throttle"
+	"github.com/lerryjay/auth-grpc-service/pkg/webauthn"
 	"gorm.io/driver/postgres"
 )
 
@@ -20,6 +27,25 @@ type Handler struct {
 	NINURL                 string
 	DLURL                  string
 	PassportURL            string
+	JWT                    *helpers.JWTManager
+	RefreshTokenTTL        time.Duration
+	AppName                string
+	EncryptionKey          []byte
+	Notifier               *notify.Service
+	OTPTTL                 time.Duration
+	OTPMaxAttempts         int32
+	PasswordPolicy         *password.Policy
+	Hasher                 *password.Hasher
+	LoginRequireVerified   string
+	Identity               *identity.Registry
+	PasswordlessLinkURL    string
+	WebAuthn               *webauthn.RelyingParty
+	DefaultRole            string
+	PermissionClaims       bool
+	StalePermissions       string
+	Throttle               *throttle.Limiter
+	OIDCLoginURL           string
+	AuthorizationCodeTTL   time.Duration
 }
 
 // New creates a new Handler with the provided database connection
@@ -45,7 +71,7 @@ func Init(url, clientID, secretKey, tokenURL, qoreidBaseURL, vninURL, ninURL, dl
 		log.Fatalln(err)
 	}
 
-	db.AutoMigrate(&models.UserORM{}, &models.UserVerificationORM{}, &models.AddressORM{}, &models.UserPermissionORM{})
+	db.AutoMigrate(&models.UserORM{}, &models.UserVerificationORM{}, &models.AddressORM{}, &models.UserPermissionORM{}, &models.RefreshTokenORM{}, &models.SessionORM{}, &models.TotpCredentialORM{}, &models.RecoveryCodeORM{}, &models.OtpORM{}, &models.PasswordHistoryORM{}, &models.LoginThrottleORM{}, &models.LinkedIdentityORM{}, &models.PasskeyCredentialORM{}, &models.WebauthnChallengeORM{}, &models.PermissionORM{}, &models.RoleORM{}, &models.UserRoleORM{}, &models.ClientORM{}, &models.AuthorizationCodeORM{}, &models.RevokedTokenORM{}, &models.ApiKeyORM{})
 
 	return Handler{
 		DB:                     db,
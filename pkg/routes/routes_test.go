@@ -0,0 +1,94 @@
+package routes
+
+import (
+	"database/sql/driver"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lerryjay/auth-grpc-service/pkg/config"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/password"
+	"github.com/lerryjay/auth-grpc-service/pkg/throttle"
+	"golang.org/x/crypto/bcrypt"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+)
+
+// testConfig holds the settings handlers are built from in tests, with
+// hashing cheap enough to run on every case
+func testConfig() config.Config {
+	return config.Config{
+		PASSWORD_HASH_ALGORITHM:  password.AlgorithmBcrypt,
+		BCRYPT_COST:              bcrypt.MinCost,
+		ARGON2_MEMORY:            64,
+		ARGON2_ITERATIONS:        1,
+		ARGON2_PARALLELISM:       1,
+		THROTTLE_FREE_ATTEMPTS:   3,
+		THROTTLE_BASE_DELAY:      time.Second,
+		THROTTLE_MAX_DELAY:       time.Minute,
+		LOCKOUT_MAX_FAILURES:     10,
+		THROTTLE_IP_MAX_FAILURES: 50,
+		LOCKOUT_WINDOW:           time.Hour,
+		LOCKOUT_DURATION:         time.Hour,
+	}
+}
+
+// newTestHandler returns a handler whose database is a sqlmock, along with
+// the mock and the throttle store so tests can check failures were counted.
+// Statements are only wrapped in transactions where the handler asks for one.
+func newTestHandler(t *testing.T) (*Handler, sqlmock.Sqlmock, *throttle.MemoryStore) {
+	t.Helper()
+
+	sqlDB, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { sqlDB.Close() })
+
+	db, err := gorm.Open(postgres.New(postgres.Config{Conn: sqlDB}), &gorm.Config{SkipDefaultTransaction: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg := testConfig()
+	hasher, err := password.NewHasher(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := throttle.NewMemoryStore(cfg.LOCKOUT_WINDOW)
+
+	h := &Handler{
+		DB:             db,
+		OTPTTL:         10 * time.Minute,
+		OTPMaxAttempts: 5,
+		Hasher:         hasher,
+		Throttle:       throttle.New(store, cfg),
+	}
+	return h, mock, store
+}
+
+// issueTestTokens lets the handler sign access tokens with a throwaway key
+// and store refresh tokens
+func issueTestTokens(t *testing.T, h *Handler) {
+	t.Helper()
+
+	jwt, err := helpers.NewJWTManager(config.Config{
+		APP_NAME:          "example",
+		APP_URL:           "https://example.com",
+		JWT_EPHEMERAL_KEY: true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h.JWT = jwt
+	h.RefreshTokenTTL = time.Hour
+}
+
+// expectInsert expects a row to be created in the table. Postgres inserts
+// return the primary key.
+func expectInsert(mock sqlmock.Sqlmock, table string, args ...driver.Value) {
+	mock.ExpectQuery(`INSERT INTO "` + table + `"`).
+		WithArgs(args...).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow("00000000-0000-0000-0000-000000000000"))
+}
@@ -0,0 +1,204 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"net"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lerryjay/auth-grpc-service/pkg/authz"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+// clientIP returns the address of the caller from the gRPC peer
+func clientIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+// incomingMetadata returns the first non empty value of the keys in the request metadata
+func incomingMetadata(ctx context.Context, keys ...string) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, key := range keys {
+		if values := md.Get(key); len(values) > 0 && values[0] != "" {
+			return values[0]
+		}
+	}
+	return ""
+}
+
+func (h *Handler) createSession(ctx context.Context, db *gorm.DB, userID string) (*models.SessionORM, error) {
+	now := time.Now()
+	session := models.SessionORM{
+		Id:         uuid.New().String(),
+		UserId:     &userID,
+		Device:     incomingMetadata(ctx, "x-device-name"),
+		IpAddress:  clientIP(ctx),
+		UserAgent:  incomingMetadata(ctx, "grpcgateway-user-agent", "user-agent"),
+		CreatedAt:  &now,
+		LastSeenAt: &now,
+	}
+	if err := db.Create(&session).Error; err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+// revokeSessions ends the user's sessions and the refresh tokens issued for
+// them. An empty sessionID revokes every session except exceptSessionID.
+func (h *Handler) revokeSessions(db *gorm.DB, userID, sessionID, exceptSessionID string) error {
+	now := time.Now()
+	return db.Transaction(func(tx *gorm.DB) error {
+		sessions := tx.Model(&models.SessionORM{}).Where("user_id = ? AND revoked_at IS NULL", userID)
+		tokens := tx.Model(&models.RefreshTokenORM{}).Where("user_id = ? AND revoked_at IS NULL", userID)
+		if sessionID != "" {
+			sessions = sessions.Where("id = ?", sessionID)
+			tokens = tokens.Where("family_id = ?", sessionID)
+		} else if exceptSessionID != "" {
+			sessions = sessions.Where("id <> ?", exceptSessionID)
+			tokens = tokens.Where("family_id <> ?", exceptSessionID)
+		}
+
+		if err := sessions.Update("revoked_at", now).Error; err != nil {
+			return err
+		}
+		return tokens.Update("revoked_at", now).Error
+	})
+}
+
+// authenticate verifies an access token and checks it hasn't been revoked
+// and its session is still active. Errors are returned as gRPC statuses.
+func (h *Handler) authenticate(token string) (*helpers.TokenClaims, error) {
+	claims, err := h.JWT.VerifyToken(token)
+	if err != nil {
+		return nil, helpers.TokenStatusError(err)
+	}
+
+	if err := h.checkTokenActive(claims); err != nil {
+		return nil, helpers.TokenStatusError(err)
+	}
+
+	return claims, nil
+}
+
+// callerSessionID returns the session of the bearer token sent with the request
+func (h *Handler) callerSessionID(ctx context.Context) string {
+	if caller, ok := authz.Caller(ctx); ok {
+		return caller.SessionID
+	}
+	token := authz.BearerToken(ctx)
+	if token == "" {
+		return ""
+	}
+	claims, err := h.JWT.VerifyToken(token)
+	if err != nil {
+		return ""
+	}
+	return claims.SessionId
+}
+
+func (h *Handler) ListSessions(ctx context.Context, req *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	var sessionsList []models.SessionORM
+	query := h.DB.Order("last_seen_at desc").Find(&sessionsList, "user_id = ? AND revoked_at IS NULL", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.Internal, "Unable to find sessions")
+	}
+
+	sessions := make([]*models.Session, 0, len(sessionsList))
+	for _, obj := range sessionsList {
+		session, err := obj.ToPB(ctx)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal,
+				"Could not convert session %s", err)
+		}
+		sessions = append(sessions, &session)
+	}
+
+	return &pb.ListSessionsResponse{Sessions: sessions}, nil
+}
+
+func (h *Handler) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*emptypb.Empty, error) {
+	if req.SessionId == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "Session id is required")
+	}
+
+	var session models.SessionORM
+	query := h.DB.First(&session, "id = ? AND user_id = ?", req.SessionId, req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "Session not found")
+	}
+
+	if err := h.revokeSessions(h.DB, req.UserId, req.SessionId, ""); err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "Unable to revoke session")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) RevokeAllSessions(ctx context.Context, req *pb.RevokeAllSessionsRequest) (*emptypb.Empty, error) {
+	err := h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := h.revokeSessions(tx, req.UserId, "", req.ExceptSessionId); err != nil {
+			return err
+		}
+		return revokeAPIKeys(tx, req.UserId)
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "Unable to revoke sessions")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) Logout(ctx context.Context, req *pb.LogoutRequest) (*emptypb.Empty, error) {
+	var userID, sessionID string
+
+	if req.Token != "" {
+		claims, err := h.JWT.VerifyToken(req.Token)
+		if err != nil {
+			return nil, helpers.TokenStatusError(err)
+		}
+		userID, sessionID = claims.Subject, claims.SessionId
+	}
+
+	if sessionID == "" && req.RefreshToken != "" {
+		var token models.RefreshTokenORM
+		query := h.DB.First(&token, "token_hash = ?", helpers.HashToken(req.RefreshToken))
+		if query.Error == nil && token.UserId != nil {
+			userID, sessionID = *token.UserId, token.FamilyId
+		}
+	}
+
+	if sessionID == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "A valid token or refresh token is required")
+	}
+
+	if err := h.revokeSessions(h.DB, userID, sessionID, ""); err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "Unable to log out")
+	}
+
+	return &emptypb.Empty{}, nil
+}
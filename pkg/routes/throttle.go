@@ -0,0 +1,112 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"log"
+	"strings"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"github.com/lerryjay/auth-grpc-service/pkg/throttle"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// Throttle scopes, so password and OTP failures are counted separately
+const (
+	throttleLogin = "login"
+	throttleOTP   = "otp"
+	throttleMFA   = "mfa"
+	// Codes and links sent by email or SMS. Every send counts.
+	throttleSend = "send"
+)
+
+// loginThrottleKey is the account key for a login attempt. Unknown login ids
+// are throttled the same way as real accounts so lockouts don't reveal which
+// accounts exist.
+func loginThrottleKey(user *models.UserORM, loginID string) string {
+	if user != nil && user.Id != "" {
+		return throttle.AccountKey(throttleLogin, user.Id)
+	}
+	return throttle.AccountKey(throttleLogin, strings.ToLower(strings.TrimSpace(loginID)))
+}
+
+// throttleKeys returns the account key along with the key for the caller's address
+func throttleKeys(ctx context.Context, scope, accountKey string) []string {
+	keys := []string{accountKey}
+	if ip := clientIP(ctx); ip != "" {
+		keys = append(keys, throttle.IPKey(scope, ip))
+	}
+	return keys
+}
+
+// checkThrottle rejects the request if any of the keys is backing off or locked out
+func (h *Handler) checkThrottle(ctx context.Context, keys ...string) error {
+	err := h.Throttle.Check(ctx, keys...)
+	if err == nil {
+		return nil
+	}
+
+	var blocked *throttle.BlockedError
+	if !errors.As(err, &blocked) {
+		log.Println("Error checking throttle", err)
+		return status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	st := status.New(codes.ResourceExhausted, "Too many failed attempts. Try again later")
+	detailed, detailErr := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(blocked.RetryAfter),
+	})
+	if detailErr != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+// checkSendThrottle limits how often codes are sent to an account or from
+// an address, and counts this send
+func (h *Handler) checkSendThrottle(ctx context.Context, accountID string) error {
+	keys := throttleKeys(ctx, throttleSend, throttle.AccountKey(throttleSend, accountID))
+	if err := h.checkThrottle(ctx, keys...); err != nil {
+		return err
+	}
+	h.recordFailure(ctx, keys...)
+	return nil
+}
+
+func (h *Handler) recordFailure(ctx context.Context, keys ...string) {
+	if err := h.Throttle.Fail(ctx, keys...); err != nil {
+		log.Println("Error recording failed attempt", err)
+	}
+}
+
+func (h *Handler) clearFailures(ctx context.Context, keys ...string) {
+	if err := h.Throttle.Reset(ctx, keys...); err != nil {
+		log.Println("Error clearing failed attempts", err)
+	}
+}
+
+func (h *Handler) UnlockAccount(ctx context.Context, req *pb.UnlockAccountRequest) (*emptypb.Empty, error) {
+	var user models.UserORM
+	query := h.DB.First(&user, "id = ?", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "User not found")
+	}
+
+	err := h.Throttle.Reset(ctx,
+		throttle.AccountKey(throttleLogin, user.Id),
+		throttle.AccountKey(throttleOTP, user.Id),
+		throttle.AccountKey(throttleMFA, user.Id),
+		throttle.AccountKey(throttleSend, user.Id))
+	if err != nil {
+		log.Println("Error unlocking account", err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,337 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/authz"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"github.com/lerryjay/auth-grpc-service/pkg/rbac"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm/clause"
+)
+
+// tokenTypeRefresh is the token_type_hint value for refresh tokens, which
+// are then looked up before access tokens
+const tokenTypeRefresh = "refresh_token"
+
+// checkTokenActive checks a verified JWT hasn't been revoked, directly or
+// by ending the session or disabling the client it was issued to
+func (h *Handler) checkTokenActive(claims *helpers.TokenClaims) error {
+	if claims.ID != "" {
+		var count int64
+		err := h.DB.Model(&models.RevokedTokenORM{}).Where("jti = ?", claims.ID).Count(&count).Error
+		if err != nil || count > 0 {
+			return helpers.ErrTokenRevoked
+		}
+	}
+
+	if claims.SessionId != "" {
+		var session models.SessionORM
+		query := h.DB.First(&session, "id = ?", claims.SessionId)
+		if query.Error != nil || session.RevokedAt != nil {
+			return helpers.ErrSessionRevoked
+		}
+	}
+
+	if claims.ClientId != "" {
+		var client models.ClientORM
+		query := h.DB.First(&client, "id = ?", claims.ClientId)
+		if query.Error != nil || client.Status != int32(models.Status_ACTIVE) {
+			return helpers.ErrClientDisabled
+		}
+	}
+	return nil
+}
+
+// issuedToken is an access or refresh token found for introspection or
+// revocation. Exactly one of the fields is set.
+type issuedToken struct {
+	claims  *helpers.TokenClaims
+	refresh *models.RefreshTokenORM
+}
+
+// userID is the user the token acts for, empty for client service tokens
+func (t *issuedToken) userID() string {
+	if t.refresh != nil {
+		if t.refresh.UserId == nil {
+			return ""
+		}
+		return *t.refresh.UserId
+	}
+	if t.claims.TokenUse == helpers.TokenUseClient {
+		return ""
+	}
+	return t.claims.Subject
+}
+
+// clientID is the OAuth2 client the token was issued to, if any
+func (t *issuedToken) clientID() string {
+	if t.refresh != nil {
+		return ""
+	}
+	return t.claims.ClientId
+}
+
+// findToken looks up an unexpired token we issued. The hint only changes
+// the order the token types are tried in.
+func (h *Handler) findToken(token, hint string) *issuedToken {
+	if token == "" {
+		return nil
+	}
+
+	findJWT := func() *issuedToken {
+		claims, err := h.JWT.VerifyBearerToken(token)
+		if err != nil {
+			return nil
+		}
+		return &issuedToken{claims: claims}
+	}
+	findRefresh := func() *issuedToken {
+		var refresh models.RefreshTokenORM
+		if err := h.DB.First(&refresh, "token_hash = ?", helpers.HashToken(token)).Error; err != nil {
+			return nil
+		}
+		if refresh.ExpiresAt == nil || time.Now().After(*refresh.ExpiresAt) {
+			return nil
+		}
+		return &issuedToken{refresh: &refresh}
+	}
+
+	lookups := []func() *issuedToken{findJWT, findRefresh}
+	if hint == tokenTypeRefresh {
+		lookups = []func() *issuedToken{findRefresh, findJWT}
+	}
+	for _, lookup := range lookups {
+		if found := lookup(); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+// introspect describes a token (RFC 7662 section 2.2). Tokens that are
+// unknown, expired or revoked are reported as inactive with no other details.
+func (h *Handler) introspect(token, hint string) *pb.IntrospectTokenResponse {
+	found := h.findToken(token, hint)
+	if found == nil {
+		return &pb.IntrospectTokenResponse{}
+	}
+
+	if refresh := found.refresh; refresh != nil {
+		if refresh.UsedAt != nil || refresh.RevokedAt != nil {
+			return &pb.IntrospectTokenResponse{}
+		}
+		res := &pb.IntrospectTokenResponse{
+			Active:    true,
+			Sub:       found.userID(),
+			Exp:       refresh.ExpiresAt.Unix(),
+			SessionId: refresh.FamilyId,
+			Iss:       h.JWT.Issuer(),
+		}
+		if refresh.CreatedAt != nil {
+			res.Iat = refresh.CreatedAt.Unix()
+		}
+		return res
+	}
+
+	claims := found.claims
+	if err := h.checkTokenActive(claims); err != nil {
+		return &pb.IntrospectTokenResponse{}
+	}
+	res := &pb.IntrospectTokenResponse{
+		Active:    true,
+		Scope:     claims.Scope,
+		ClientId:  claims.ClientId,
+		Sub:       claims.Subject,
+		TokenType: "Bearer",
+		SessionId: claims.SessionId,
+		Iss:       claims.Issuer,
+	}
+	if claims.ExpiresAt != nil {
+		res.Exp = claims.ExpiresAt.Unix()
+	}
+	if claims.IssuedAt != nil {
+		res.Iat = claims.IssuedAt.Unix()
+	}
+	return res
+}
+
+// revokeToken revokes a token. Access tokens are added to the revocation
+// list until they expire; revoking a refresh token ends its session, which
+// also invalidates the access tokens issued with it (RFC 7009 section 2.1).
+func (h *Handler) revokeToken(found *issuedToken) error {
+	if refresh := found.refresh; refresh != nil {
+		if refresh.UserId == nil {
+			return nil
+		}
+		return h.revokeSessions(h.DB, *refresh.UserId, refresh.FamilyId, "")
+	}
+
+	if found.claims.ID == "" {
+		return nil
+	}
+	_, err := h.revokeJWT(found.claims)
+	return err
+}
+
+// revokeJWT adds a verified JWT to the revocation list. It reports false if
+// the token was already on it.
+func (h *Handler) revokeJWT(claims *helpers.TokenClaims) (bool, error) {
+	now := time.Now()
+	revoked := models.RevokedTokenORM{Jti: claims.ID, RevokedAt: &now}
+	if claims.ExpiresAt != nil {
+		revoked.ExpiresAt = &claims.ExpiresAt.Time
+	}
+	query := h.DB.Clauses(clause.OnConflict{DoNothing: true}).Create(&revoked)
+	if query.Error != nil {
+		return false, query.Error
+	}
+
+	// The list only needs tokens that would otherwise still be accepted
+	expired := h.DB.Where("expires_at < ?", now.Add(-time.Hour)).Delete(&models.RevokedTokenORM{})
+	if expired.Error != nil {
+		log.Println("Error deleting expired revoked tokens", expired.Error)
+	}
+	return query.RowsAffected == 1, nil
+}
+
+func (h *Handler) IntrospectToken(ctx context.Context, req *pb.IntrospectTokenRequest) (*pb.IntrospectTokenResponse, error) {
+	return h.introspect(req.Token, req.TokenTypeHint), nil
+}
+
+func (h *Handler) RevokeToken(ctx context.Context, req *pb.RevokeTokenRequest) (*emptypb.Empty, error) {
+	found := h.findToken(req.Token, req.TokenTypeHint)
+	if found == nil {
+		return &emptypb.Empty{}, nil
+	}
+
+	// Users can revoke their own tokens and clients the tokens issued to
+	// them. Anyone else, including API keys without the scope, needs
+	// sessions.revoke.
+	caller, _ := authz.Caller(ctx)
+	owner := caller != nil &&
+		((caller.UserID != "" && found.userID() == caller.UserID &&
+			(caller.APIKeyID == "" || caller.HasScope("sessions.revoke"))) ||
+			(caller.ClientID != "" && found.clientID() == caller.ClientID))
+	if !owner {
+		if err := h.requirePermission(ctx, "sessions.revoke"); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := h.revokeToken(found); err != nil {
+		log.Println("Error revoking token", err)
+		return nil, status.Errorf(codes.Internal, "Unable to revoke token")
+	}
+	return &emptypb.Empty{}, nil
+}
+
+// introspectionResponse is the JSON form of IntrospectTokenResponse
+type introspectionResponse struct {
+	Active    bool   `json:"active"`
+	Scope     string `json:"scope,omitempty"`
+	ClientID  string `json:"client_id,omitempty"`
+	Sub       string `json:"sub,omitempty"`
+	Exp       int64  `json:"exp,omitempty"`
+	Iat       int64  `json:"iat,omitempty"`
+	TokenType string `json:"token_type,omitempty"`
+	SessionID string `json:"sid,omitempty"`
+	Iss       string `json:"iss,omitempty"`
+}
+
+// tokenEndpointClient authenticates the client calling one of the OAuth2
+// HTTP endpoints, writing the error response when it fails
+func (h *Handler) tokenEndpointClient(w http.ResponseWriter, r *http.Request) *models.ClientORM {
+	clientID, secret, oerr := requestClientCredentials(r)
+	if oerr != nil {
+		writeOAuthError(w, oerr)
+		return nil
+	}
+
+	client, oerr := h.verifyClientSecret(clientID, secret)
+	if oerr != nil {
+		writeOAuthError(w, oerr)
+		return nil
+	}
+	return client
+}
+
+// serveIntrospect is the introspection endpoint. Callers authenticate as a
+// client allowed the tokens.introspect scope.
+func (h *Handler) serveIntrospect(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, &oauthError{"invalid_request", "Unable to parse the request body"})
+		return
+	}
+
+	client := h.tokenEndpointClient(w, r)
+	if client == nil {
+		return
+	}
+	if !rbac.Allowed(scopeGrants(strings.Fields(client.Scopes)), rbac.Check{Permission: "tokens.introspect"}) {
+		writeJSON(w, http.StatusForbidden, &oauthError{"unauthorized_client", "The client is not allowed to introspect tokens"})
+		return
+	}
+
+	res := h.introspect(r.PostForm.Get("token"), r.PostForm.Get("token_type_hint"))
+	writeJSON(w, http.StatusOK, introspectionResponse{
+		Active:    res.Active,
+		Scope:     res.Scope,
+		ClientID:  res.ClientId,
+		Sub:       res.Sub,
+		Exp:       res.Exp,
+		Iat:       res.Iat,
+		TokenType: res.TokenType,
+		SessionID: res.SessionId,
+		Iss:       res.Iss,
+	})
+}
+
+// serveRevoke is the revocation endpoint. Clients can only revoke the
+// tokens issued to them.
+func (h *Handler) serveRevoke(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		writeJSON(w, http.StatusBadRequest, &oauthError{"invalid_request", "Unable to parse the request body"})
+		return
+	}
+
+	client := h.tokenEndpointClient(w, r)
+	if client == nil {
+		return
+	}
+
+	found := h.findToken(r.PostForm.Get("token"), r.PostForm.Get("token_type_hint"))
+	if found == nil {
+		// Invalid tokens need no action (RFC 7009 section 2.2)
+		w.WriteHeader(http.StatusOK)
+		return
+	}
+	if found.clientID() != client.Id {
+		writeJSON(w, http.StatusBadRequest, &oauthError{"unauthorized_client", "The token was not issued to this client"})
+		return
+	}
+
+	if err := h.revokeToken(found); err != nil {
+		log.Println("Error revoking token", err)
+		writeJSON(w, http.StatusServiceUnavailable, &oauthError{"temporarily_unavailable", "Unable to revoke token"})
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
@@ -0,0 +1,88 @@
+package routes
+
+import (
+	"sort"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"gorm.io/gorm"
+)
+
+// Values of STALE_PERMISSION_CLAIMS
+const (
+	StalePermissionsReject = "reject"
+	StalePermissionsFlag   = "flag"
+)
+
+// tokenAuthorization collects the permission claims for a token issued to
+// the user
+func tokenAuthorization(db *gorm.DB, userID string) (*helpers.TokenAuthorization, error) {
+	// Read the version first so a change made while the claims are being
+	// collected leaves the token stale rather than silently out of date
+	var versions []int64
+	err := db.Model(&models.UserORM{}).Where("id = ?", userID).Pluck("permissions_version", &versions).Error
+	if err != nil {
+		return nil, err
+	}
+	if len(versions) == 0 {
+		return nil, gorm.ErrRecordNotFound
+	}
+
+	grants, err := userGrants(db, userID, true)
+	if err != nil {
+		return nil, err
+	}
+	seen := make(map[string]bool)
+	permissions := []string{}
+	for _, grant := range grants {
+		if name := grant.Name(); !seen[name] {
+			seen[name] = true
+			permissions = append(permissions, name)
+		}
+	}
+	sort.Strings(permissions)
+
+	roles := []string{}
+	err = db.Model(&models.RoleORM{}).
+		Joins("JOIN user_roles ON user_roles.role_id = roles.id").
+		Where("user_roles.user_id = ? AND user_roles.resource_type = ''", userID).
+		Order("roles.name").
+		Pluck("roles.name", &roles).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return &helpers.TokenAuthorization{
+		Roles:       roles,
+		Permissions: permissions,
+		Version:     versions[0],
+	}, nil
+}
+
+// checkPermissionClaims compares the permissions version in the token with
+// the user's. Stale claims are rejected or flagged on the response,
+// depending on STALE_PERMISSION_CLAIMS.
+func (h *Handler) checkPermissionClaims(claims *helpers.TokenClaims, res *pb.ValidateTokenResponse) error {
+	if claims.PermissionsVersion == nil {
+		return nil
+	}
+	res.Roles = claims.Roles
+	res.Permissions = claims.Permissions
+	res.PermissionsVersion = *claims.PermissionsVersion
+
+	var versions []int64
+	err := h.DB.Model(&models.UserORM{}).Where("id = ?", claims.Subject).Pluck("permissions_version", &versions).Error
+	if err != nil || len(versions) == 0 {
+		return helpers.ErrTokenClaims
+	}
+	if versions[0] == *claims.PermissionsVersion {
+		return nil
+	}
+
+	if h.StalePermissions == StalePermissionsFlag {
+		res.PermissionsStale = true
+		return nil
+	}
+	return helpers.ErrPermissionsStale
+}
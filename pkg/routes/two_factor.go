@@ -0,0 +1,311 @@
+package routes
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"github.com/lerryjay/auth-grpc-service/pkg/throttle"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+var errInvalidSecondFactor = errors.New("invalid second factor")
+
+// completeLogin is called once a user has passed their first factor. Users
+// with two-factor authentication get an MFA challenge instead of tokens.
+func (h *Handler) completeLogin(ctx context.Context, user *models.UserORM) (*pb.LoginUserResponse, error) {
+	if user.Enable2FA {
+		mfaToken, err := h.JWT.GenerateMFAToken(user.Id)
+		if err != nil {
+			log.Println(err)
+			return nil, status.Errorf(codes.Internal,
+				"Error authenticating user!")
+		}
+
+		return &pb.LoginUserResponse{
+			MfaRequired: true,
+			MfaToken:    mfaToken,
+			Message:     "Two-factor authentication required",
+		}, nil
+	}
+
+	response, err := h.issueTokens(ctx, h.DB, user, "")
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal,
+			"Error authenticating user!")
+	}
+
+	response.Message = "Login Successful"
+	return response, nil
+}
+
+// verifyTOTP checks a code against the user's confirmed authenticator and
+// records the time step so the same code can't be used twice
+func (h *Handler) verifyTOTP(userID, code string) error {
+	var credential models.TotpCredentialORM
+	query := h.DB.First(&credential, "user_id = ? AND confirmed_at IS NOT NULL", userID)
+	if query.Error != nil {
+		return errInvalidSecondFactor
+	}
+
+	secret, err := helpers.DecryptSecret(h.EncryptionKey, credential.EncryptedSecret)
+	if err != nil {
+		return err
+	}
+
+	step, ok := helpers.ValidateTOTP(secret, code, time.Now())
+	if !ok {
+		return errInvalidSecondFactor
+	}
+
+	result := h.DB.Model(&models.TotpCredentialORM{}).
+		Where("id = ? AND last_used_step < ?", credential.Id, step).
+		Update("last_used_step", step)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errInvalidSecondFactor
+	}
+
+	return nil
+}
+
+// checkSecondFactor runs a second factor check under the user's MFA
+// throttle, so codes can't be guessed faster than the backoff allows
+func (h *Handler) checkSecondFactor(ctx context.Context, userID string, check func() error) error {
+	accountKey := throttle.AccountKey(throttleMFA, userID)
+	keys := throttleKeys(ctx, throttleMFA, accountKey)
+	if err := h.checkThrottle(ctx, keys...); err != nil {
+		return err
+	}
+
+	if err := check(); err != nil {
+		if errors.Is(err, errInvalidSecondFactor) {
+			h.recordFailure(ctx, keys...)
+		}
+		return secondFactorError(err)
+	}
+	h.clearFailures(ctx, accountKey)
+	return nil
+}
+
+// verifyMFAToken checks an MFA challenge token that hasn't been exchanged yet
+func (h *Handler) verifyMFAToken(token string) (*helpers.TokenClaims, error) {
+	claims, err := h.JWT.VerifyMFAToken(token)
+	if err == nil {
+		err = h.checkTokenActive(claims)
+	}
+	if err != nil {
+		return nil, helpers.TokenStatusError(err)
+	}
+	return claims, nil
+}
+
+// consumeMFAToken records the jti of an MFA challenge once it has been
+// exchanged, so the token can't start another attempt
+func (h *Handler) consumeMFAToken(claims *helpers.TokenClaims) error {
+	if claims.ID == "" {
+		return helpers.TokenStatusError(helpers.ErrTokenClaims)
+	}
+	consumed, err := h.revokeJWT(claims)
+	if err != nil {
+		log.Println("Error consuming MFA token", err)
+		return status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+	if !consumed {
+		return helpers.TokenStatusError(helpers.ErrTokenRevoked)
+	}
+	return nil
+}
+
+func secondFactorError(err error) error {
+	if errors.Is(err, errInvalidSecondFactor) {
+		return status.Errorf(codes.InvalidArgument, "Invalid authentication code")
+	}
+	log.Println(err)
+	return status.Errorf(codes.Internal, "An unexpected error occurred")
+}
+
+func (h *Handler) EnrollTOTP(ctx context.Context, req *pb.EnrollTOTPRequest) (*pb.EnrollTOTPResponse, error) {
+	var user models.UserORM
+	query := h.DB.First(&user, "id = ?", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "User not found")
+	}
+
+	if user.Enable2FA {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Two-factor authentication is already enabled")
+	}
+
+	secret, err := helpers.GenerateTOTPSecret()
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	encrypted, err := helpers.EncryptSecret(h.EncryptionKey, secret)
+	if errors.Is(err, helpers.ErrEncryptionKeyMissing) {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"Two-factor authentication is not configured")
+	}
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "An unexpected error occurred")
+	}
+
+	now := time.Now()
+	credential := models.TotpCredentialORM{
+		Id:              uuid.New().String(),
+		UserId:          &user.Id,
+		EncryptedSecret: encrypted,
+		CreatedAt:       &now,
+	}
+
+	// Starting again replaces any enrollment that was never confirmed
+	var recoveryCodes []string
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", user.Id).Delete(&models.TotpCredentialORM{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Create(&credential).Error; err != nil {
+			return err
+		}
+
+		var err error
+		recoveryCodes, err = replaceRecoveryCodes(tx, user.Id)
+		return err
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "Unable to save authenticator")
+	}
+
+	account := user.Email
+	if account == "" {
+		account = user.Username
+	}
+
+	return &pb.EnrollTOTPResponse{
+		Secret:        secret,
+		Uri:           helpers.TOTPURI(secret, h.AppName, account),
+		RecoveryCodes: recoveryCodes,
+	}, nil
+}
+
+func (h *Handler) ConfirmTOTP(ctx context.Context, req *pb.ConfirmTOTPRequest) (*emptypb.Empty, error) {
+	var credential models.TotpCredentialORM
+	query := h.DB.First(&credential, "user_id = ? AND confirmed_at IS NULL", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.FailedPrecondition,
+			"No pending authenticator enrollment")
+	}
+
+	var step int64
+	err := h.checkSecondFactor(ctx, req.UserId, func() error {
+		secret, err := helpers.DecryptSecret(h.EncryptionKey, credential.EncryptedSecret)
+		if err != nil {
+			return err
+		}
+
+		var ok bool
+		step, ok = helpers.ValidateTOTP(secret, req.Code, time.Now())
+		if !ok {
+			return errInvalidSecondFactor
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		credential.ConfirmedAt = &now
+		credential.LastUsedStep = step
+		if err := tx.Save(&credential).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.UserORM{}).Where("id = ?", req.UserId).Update("Enable2FA", true).Error
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "Unable to enable two-factor authentication")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) DisableTOTP(ctx context.Context, req *pb.DisableTOTPRequest) (*emptypb.Empty, error) {
+	err := h.checkSecondFactor(ctx, req.UserId, func() error {
+		return h.verifyTOTP(req.UserId, req.Code)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Where("user_id = ?", req.UserId).Delete(&models.TotpCredentialORM{}).Error; err != nil {
+			return err
+		}
+		if err := tx.Where("user_id = ?", req.UserId).Delete(&models.RecoveryCodeORM{}).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.UserORM{}).Where("id = ?", req.UserId).Update("Enable2FA", false).Error
+	})
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal, "Unable to disable two-factor authentication")
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) VerifyMFA(ctx context.Context, req *pb.VerifyMFARequest) (*pb.LoginUserResponse, error) {
+	claims, err := h.verifyMFAToken(req.MfaToken)
+	if err != nil {
+		return nil, err
+	}
+
+	var user models.UserORM
+	query := h.DB.First(&user, "id = ?", claims.Subject)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.Unauthenticated, "Invalid authentication token")
+	}
+
+	err = h.checkSecondFactor(ctx, user.Id, func() error {
+		if req.RecoveryCode != "" {
+			return h.useRecoveryCode(user.Id, req.RecoveryCode)
+		}
+		return h.verifyTOTP(user.Id, req.Code)
+	})
+	if err != nil {
+		return nil, err
+	}
+	if err := h.consumeMFAToken(claims); err != nil {
+		return nil, err
+	}
+
+	response, err := h.issueTokens(ctx, h.DB, &user, "")
+	if err != nil {
+		log.Println(err)
+		return nil, status.Errorf(codes.Internal,
+			"Error authenticating user!")
+	}
+
+	response.Message = "Login Successful"
+	return response, nil
+}
@@ -0,0 +1,95 @@
+package routes
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha1"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
+	"github.com/lerryjay/auth-grpc-service/pkg/throttle"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// totpCode computes the code an authenticator app shows for the step, as
+// RFC 6238 describes
+func totpCode(t *testing.T, secret string, step int64) string {
+	t.Helper()
+	key, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := make([]byte, 8)
+	binary.BigEndian.PutUint64(msg, uint64(step))
+	mac := hmac.New(sha1.New, key)
+	mac.Write(msg)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%06d", value%1000000)
+}
+
+func TestVerifyTOTPRejectsReusedStep(t *testing.T) {
+	const (
+		userID       = "6f1c3b8e-4a8e-4c52-9a43-0d3f9a1e7b21"
+		credentialID = "3c8f1a2b-5d6e-4f70-8192-a3b4c5d6e7f8"
+	)
+	ctx := context.Background()
+	h, mock, store := newTestHandler(t)
+	h.EncryptionKey = make([]byte, 32)
+
+	secret, err := helpers.GenerateTOTPSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypted, err := helpers.EncryptSecret(h.EncryptionKey, secret)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Codes are accepted a step either side, and verifyTOTP records the
+	// step the code was issued for, so crossing into the next step is fine
+	step := time.Now().Unix() / 30
+	code := totpCode(t, secret, step)
+
+	credential := func(lastUsedStep int64) *sqlmock.Rows {
+		return sqlmock.NewRows([]string{"id", "user_id", "encrypted_secret", "last_used_step", "confirmed_at"}).
+			AddRow(credentialID, userID, encrypted, lastUsedStep, time.Now())
+	}
+	// The step is only recorded if it is later than the last one used
+	expectStepRecorded := func(updated int64) {
+		mock.ExpectExec(`UPDATE "totp_credentials" SET "last_used_step"=\$1 WHERE id = \$2 AND last_used_step < \$3`).
+			WithArgs(step, credentialID, step).
+			WillReturnResult(sqlmock.NewResult(0, updated))
+	}
+
+	mock.ExpectQuery(`FROM "totp_credentials"`).WillReturnRows(credential(step - 1))
+	expectStepRecorded(1)
+	err = h.checkSecondFactor(ctx, userID, func() error { return h.verifyTOTP(userID, code) })
+	if err != nil {
+		t.Fatalf("first use: %v", err)
+	}
+
+	mock.ExpectQuery(`FROM "totp_credentials"`).WillReturnRows(credential(step))
+	expectStepRecorded(0)
+	err = h.checkSecondFactor(ctx, userID, func() error { return h.verifyTOTP(userID, code) })
+	if got := status.Code(err); got != codes.InvalidArgument {
+		t.Errorf("second use: got %v, want %v (%v)", got, codes.InvalidArgument, err)
+	}
+
+	if err := mock.ExpectationsWereMet(); err != nil {
+		t.Error(err)
+	}
+	record, err := store.Get(ctx, throttle.AccountKey(throttleMFA, userID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Failures != 1 {
+		t.Errorf("got %d recorded failures, want 1", record.Failures)
+	}
+}
@@ -7,7 +7,6 @@ import (
 	"log"
 	"time"
 
-	"github.com/lerryjay/auth-grpc-service/pkg/helpers"
 	"github.com/lerryjay/auth-grpc-service/pkg/pb"
 	"github.com/lerryjay/auth-grpc-service/pkg/pb/model"
 	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
@@ -31,15 +30,50 @@ func (h *Handler) CreateUser(ctx context.Context, req *models.User) (*models.Use
 			"Email or Phone Number alredy exists")
 	}
 
+	// Accounts can be created without a password and have one set later
+	if req.Password != "" {
+		candidate := models.UserORM{Username: req.Username, Email: req.Email}
+		if err := h.checkPassword("Password", &candidate, req.Password); err != nil {
+			return nil, err
+		}
+	}
+
+	// Role is kept for older clients; the user's permissions come from the
+	// role assignment made here
+	if req.Role == "" {
+		req.Role = h.DefaultRole
+	}
+	if req.Role != "" {
+		var count int64
+		h.DB.Model(&models.RoleORM{}).Where("name = ?", req.Role).Count(&count)
+		if count == 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "Unknown role %s", req.Role)
+		}
+	}
+	// Sign up is public, so only callers who can assign roles may pick one
+	if req.Role != h.DefaultRole {
+		if err := h.requirePermission(ctx, "roles.assign"); err != nil {
+			return nil, err
+		}
+	}
+
 	req.Id = uuid.New().String()
-	hashPassword, err := helpers.HashPassword(req.Password)
-	if err != nil {
-		log.Println(err)
-		return nil, status.Errorf(codes.Internal,
-			"Could not generate new user password hash")
+	// Ownership of the email and phone is proven through the verification RPCs
+	req.EmailVerified = false
+	req.PhoneVerified = false
+	req.Enable2FA = false
+	req.PermissionsVersion = 0
+	// Without a password the column stays empty, so no password can match it
+	if req.Password != "" {
+		hashPassword, err := h.Hasher.Hash(req.Password)
+		if err != nil {
+			log.Println(err)
+			return nil, status.Errorf(codes.Internal,
+				"Could not generate new user password hash")
+		}
+		req.Password = hashPassword
 	}
 
-	req.Password = hashPassword
 	userOrm, err := req.ToORM(ctx)
 	if err != nil {
 		log.Println(err)
@@ -47,9 +81,14 @@ func (h *Handler) CreateUser(ctx context.Context, req *models.User) (*models.Use
 			"Unable to create user. DB failed to insert %s", err)
 	}
 
-	query := h.DB.Create(&userOrm)
-	if query.Error != nil {
-		log.Println(query.Error)
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Create(&userOrm).Error; err != nil {
+			return err
+		}
+		return assignNamedRole(tx, userOrm.Id, req.Role)
+	})
+	if err != nil {
+		log.Println(err)
 		return nil, status.Errorf(codes.Internal,
 			"Unable to create user. DB failed to insert")
 	}
@@ -139,7 +178,10 @@ func (h *Handler) ListUsers(ctx context.Context, req *pb.ListUsersRequest) (*pb.
 	query := h.DB.Model(&models.UserORM{})
 
 	if req.Role != nil && *req.Role != "" {
-		query = query.Where("role = ?", req.Role)
+		query = query.Where("id IN (?)", h.DB.Model(&models.UserRoleORM{}).
+			Joins("JOIN roles ON roles.id = user_roles.role_id").
+			Where("roles.name = ?", *req.Role).
+			Select("user_roles.user_id"))
 	}
 
 	if req.Name != nil && *req.Name != "" {
@@ -230,8 +272,29 @@ func (h *Handler) UpdateUser(ctx context.Context, req *models.User) (*models.Use
 	userData.VerificationStatus = user.VerificationStatus
 	userData.ImageUrl = user.ImageUrl
 	userData.Username = user.Username
-
-	h.DB.Save(&userData)
+	userData.Enable2FA = user.Enable2FA
+	userData.EmailVerified = user.EmailVerified
+	// A new phone number has to be verified again
+	userData.PhoneVerified = user.PhoneVerified && userData.Telephone == user.Telephone
+
+	err = h.DB.Transaction(func(tx *gorm.DB) error {
+		// The version is only changed by rbac.BumpVersion. Writing back the
+		// one read above could undo a concurrent bump.
+		if err := tx.Omit("PermissionsVersion").Save(&userData).Error; err != nil {
+			return err
+		}
+		if userData.Telephone == user.Telephone {
+			return nil
+		}
+		// Codes sent to the old number must not verify the new one
+		return tx.Model(&models.OtpORM{}).
+			Where("user_id = ? AND purpose = ? AND consumed_at IS NULL", user.Id, int32(models.OtpPurpose_PHONE_VERIFY)).
+			Update("consumed_at", time.Now()).Error
+	})
+	if err != nil {
+		log.Println("Error updating user", err)
+		return nil, status.Errorf(codes.Internal, "Unable to update user")
+	}
 
 	return req, nil
 }
@@ -0,0 +1,190 @@
+package routes
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/notify"
+	"github.com/lerryjay/auth-grpc-service/pkg/pb"
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"github.com/lerryjay/auth-grpc-service/pkg/throttle"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+	"gorm.io/gorm"
+)
+
+// Values of LOGIN_REQUIRE_VERIFIED
+const (
+	RequireVerifiedNone  = ""
+	RequireVerifiedEmail = "email"
+	RequireVerifiedPhone = "phone"
+	RequireVerifiedAny   = "any"
+	RequireVerifiedAll   = "all"
+)
+
+// loginVerified reports whether the user has verified enough of their
+// contact details to sign in with a password
+func (h *Handler) loginVerified(user *models.UserORM) bool {
+	switch h.LoginRequireVerified {
+	case RequireVerifiedEmail:
+		return user.EmailVerified
+	case RequireVerifiedPhone:
+		return user.PhoneVerified
+	case RequireVerifiedAny:
+		return user.EmailVerified || user.PhoneVerified
+	case RequireVerifiedAll:
+		return user.EmailVerified && user.PhoneVerified
+	}
+	return true
+}
+
+func notVerifiedError(user *models.UserORM) error {
+	st := status.New(codes.FailedPrecondition, "Verify your account before signing in")
+	detailed, err := st.WithDetails(&errdetails.ErrorInfo{
+		Reason: "ACCOUNT_NOT_VERIFIED",
+		Domain: "auth",
+		Metadata: map[string]string{
+			"email_verified": boolString(user.EmailVerified),
+			"phone_verified": boolString(user.PhoneVerified),
+		},
+	})
+	if err != nil {
+		return st.Err()
+	}
+	return detailed.Err()
+}
+
+func boolString(b bool) string {
+	if b {
+		return "true"
+	}
+	return "false"
+}
+
+// sendVerification issues a code for the purpose and delivers it to the address
+func (h *Handler) sendVerification(ctx context.Context, user *models.UserORM, purpose models.OtpPurpose, channel notify.Channel, to, template string) (*pb.SendVerificationResponse, error) {
+	if err := h.checkSendThrottle(ctx, user.Id); err != nil {
+		return nil, err
+	}
+
+	code, expiresAt, err := h.issueOTP(h.DB, user.Id, purpose)
+	if err != nil {
+		log.Println("Error creating verification code", err)
+		return nil, status.Errorf(codes.Internal, "Unable to send verification code")
+	}
+
+	err = h.Notifier.Send(ctx, channel, to, template, map[string]interface{}{
+		"FirstName":        user.Firstname,
+		"Code":             code,
+		"ExpiresInMinutes": int(h.OTPTTL.Minutes()),
+	})
+	if err != nil {
+		log.Println("Error sending verification code", err)
+		return nil, status.Errorf(codes.Internal, "Unable to send verification code")
+	}
+
+	destination := notify.MaskEmail(to)
+	if channel == notify.SMS {
+		destination = notify.MaskPhone(to)
+	}
+
+	return &pb.SendVerificationResponse{
+		Destination: destination,
+		ExpiresAt:   expiresAt.Format(time.RFC3339),
+	}, nil
+}
+
+// confirmVerification consumes the code and sets the verified column
+func (h *Handler) confirmVerification(ctx context.Context, userID string, purpose models.OtpPurpose, code, column string) error {
+	accountKey := throttle.AccountKey(throttleOTP, userID)
+	keys := throttleKeys(ctx, throttleOTP, accountKey)
+	if err := h.checkThrottle(ctx, keys...); err != nil {
+		return err
+	}
+
+	err := h.DB.Transaction(func(tx *gorm.DB) error {
+		if err := h.checkOTP(tx, userID, purpose, code, true); err != nil {
+			return err
+		}
+		return tx.Model(&models.UserORM{}).Where("id = ?", userID).Update(column, true).Error
+	})
+	if err != nil {
+		log.Println("Error confirming verification code", err)
+		if isOTPFailure(err) {
+			h.recordFailure(ctx, keys...)
+		}
+		return otpError(err)
+	}
+	h.clearFailures(ctx, accountKey)
+
+	return nil
+}
+
+func (h *Handler) SendEmailVerification(ctx context.Context, req *pb.SendVerificationRequest) (*pb.SendVerificationResponse, error) {
+	var user models.UserORM
+	query := h.DB.First(&user, "id = ?", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "User not found")
+	}
+
+	if user.Email == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "User has no email address")
+	}
+	if user.EmailVerified {
+		return nil, status.Errorf(codes.FailedPrecondition, "Email address is already verified")
+	}
+
+	return h.sendVerification(ctx, &user, models.OtpPurpose_EMAIL_VERIFY, notify.Email, user.Email, "email_verification")
+}
+
+func (h *Handler) ConfirmEmail(ctx context.Context, req *pb.ConfirmVerificationRequest) (*emptypb.Empty, error) {
+	var user models.UserORM
+	query := h.DB.First(&user, "id = ?", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "User not found")
+	}
+
+	if err := h.confirmVerification(ctx, user.Id, models.OtpPurpose_EMAIL_VERIFY, req.Code, "email_verified"); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
+
+func (h *Handler) SendPhoneVerification(ctx context.Context, req *pb.SendVerificationRequest) (*pb.SendVerificationResponse, error) {
+	var user models.UserORM
+	query := h.DB.First(&user, "id = ?", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "User not found")
+	}
+
+	if user.Telephone == "" {
+		return nil, status.Errorf(codes.FailedPrecondition, "User has no phone number")
+	}
+	if user.PhoneVerified {
+		return nil, status.Errorf(codes.FailedPrecondition, "Phone number is already verified")
+	}
+
+	return h.sendVerification(ctx, &user, models.OtpPurpose_PHONE_VERIFY, notify.SMS, user.Telephone, "phone_verification")
+}
+
+func (h *Handler) ConfirmPhone(ctx context.Context, req *pb.ConfirmVerificationRequest) (*emptypb.Empty, error) {
+	var user models.UserORM
+	query := h.DB.First(&user, "id = ?", req.UserId)
+	if query.Error != nil {
+		log.Println(query.Error)
+		return nil, status.Errorf(codes.NotFound, "User not found")
+	}
+
+	if err := h.confirmVerification(ctx, user.Id, models.OtpPurpose_PHONE_VERIFY, req.Code, "phone_verified"); err != nil {
+		return nil, err
+	}
+
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,68 @@
+package throttle
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps records in process. Counters are lost on restart and are
+// not shared between replicas, so it is only suited to a single instance.
+type MemoryStore struct {
+	mu      sync.Mutex
+	records map[string]Record
+	ttl     time.Duration
+	swept   time.Time
+}
+
+// NewMemoryStore creates a store that drops records which have been idle
+// and unblocked for longer than ttl
+func NewMemoryStore(ttl time.Duration) *MemoryStore {
+	return &MemoryStore{
+		records: map[string]Record{},
+		ttl:     ttl,
+	}
+}
+
+func (s *MemoryStore) Get(ctx context.Context, key string) (Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.records[key], nil
+}
+
+func (s *MemoryStore) Update(ctx context.Context, key string, fn func(*Record)) (Record, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sweep()
+
+	record := s.records[key]
+	fn(&record)
+	s.records[key] = record
+
+	return record, nil
+}
+
+func (s *MemoryStore) Delete(ctx context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.records, key)
+	return nil
+}
+
+// sweep removes stale records at most once per ttl. Callers hold the lock.
+func (s *MemoryStore) sweep() {
+	now := time.Now()
+	if s.ttl <= 0 || now.Sub(s.swept) < s.ttl {
+		return
+	}
+	s.swept = now
+
+	for key, record := range s.records {
+		if now.After(record.BlockedUntil) && now.Sub(record.LastFailure) > s.ttl {
+			delete(s.records, key)
+		}
+	}
+}
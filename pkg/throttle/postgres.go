@@ -0,0 +1,87 @@
+package throttle
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	models "github.com/lerryjay/auth-grpc-service/pkg/pb/model"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// PostgresStore keeps records in the login_throttles table so counters are
+// shared by every replica and survive restarts
+type PostgresStore struct {
+	db *gorm.DB
+}
+
+// NewPostgresStore creates a store on db. The table is migrated with the
+// rest of the models.
+func NewPostgresStore(db *gorm.DB) *PostgresStore {
+	return &PostgresStore{db: db}
+}
+
+func (s *PostgresStore) Get(ctx context.Context, key string) (Record, error) {
+	var row models.LoginThrottleORM
+	err := s.db.WithContext(ctx).First(&row, "key = ?", key).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return Record{}, nil
+	}
+	if err != nil {
+		return Record{}, err
+	}
+	return toRecord(row), nil
+}
+
+func (s *PostgresStore) Update(ctx context.Context, key string, fn func(*Record)) (Record, error) {
+	var record Record
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// Make sure the row exists so it can be locked
+		err := tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&models.LoginThrottleORM{Key: key}).Error
+		if err != nil {
+			return err
+		}
+
+		var row models.LoginThrottleORM
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).First(&row, "key = ?", key).Error
+		if err != nil {
+			return err
+		}
+
+		record = toRecord(row)
+		fn(&record)
+
+		return tx.Model(&models.LoginThrottleORM{}).Where("key = ?", key).Updates(map[string]interface{}{
+			"failures":        int32(record.Failures),
+			"last_failure_at": nullTime(record.LastFailure),
+			"blocked_until":   nullTime(record.BlockedUntil),
+		}).Error
+	})
+
+	return record, err
+}
+
+func (s *PostgresStore) Delete(ctx context.Context, key string) error {
+	return s.db.WithContext(ctx).Where("key = ?", key).Delete(&models.LoginThrottleORM{}).Error
+}
+
+func toRecord(row models.LoginThrottleORM) Record {
+	record := Record{Failures: int(row.Failures)}
+	if row.LastFailureAt != nil {
+		record.LastFailure = *row.LastFailureAt
+	}
+	if row.BlockedUntil != nil {
+		record.BlockedUntil = *row.BlockedUntil
+	}
+	return record
+}
+
+func nullTime(t time.Time) interface{} {
+	if t.IsZero() {
+		return nil
+	}
+	return t
+}
@@ -0,0 +1,202 @@
+// Package throttle slows down and locks out repeated failed attempts, such as
+// password or OTP guesses, against an account or from a client address.
+package throttle
+
+import (
+	"context"
+	"errors"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/config"
+)
+
+// Record is what the store keeps for each throttled key
+type Record struct {
+	Failures     int
+	LastFailure  time.Time
+	BlockedUntil time.Time
+}
+
+// Store persists records. Update must apply fn atomically so concurrent
+// failures are all counted.
+type Store interface {
+	Get(ctx context.Context, key string) (Record, error)
+	Update(ctx context.Context, key string, fn func(*Record)) (Record, error)
+	Delete(ctx context.Context, key string) error
+}
+
+// Rule is the threshold applied to one kind of key
+type Rule struct {
+	// Failures allowed before each further failure is delayed
+	FreeAttempts int
+	// Failures after which the key is locked out
+	MaxFailures int
+	// How long failures are remembered after the last one
+	Window time.Duration
+	// First backoff delay, doubled on every further failure
+	BaseDelay time.Duration
+	// Backoff delays are capped at MaxDelay
+	MaxDelay time.Duration
+	// How long the key is locked once MaxFailures is reached
+	LockoutDuration time.Duration
+}
+
+// ErrBlocked is returned while a key is backing off or locked out
+var ErrBlocked = errors.New("too many failed attempts")
+
+// BlockedError reports how long the caller has to wait before trying again
+type BlockedError struct {
+	Key        string
+	RetryAfter time.Duration
+}
+
+func (e *BlockedError) Error() string {
+	return ErrBlocked.Error()
+}
+
+func (e *BlockedError) Unwrap() error {
+	return ErrBlocked
+}
+
+// Key kinds. Accounts and client addresses have separate thresholds since a
+// single address may legitimately serve many users.
+const (
+	KindAccount = "account"
+	KindIP      = "ip"
+)
+
+// Limiter applies the rules to the keys kept in the store
+type Limiter struct {
+	store Store
+	rules map[string]Rule
+	now   func() time.Time
+}
+
+// New creates a limiter using the rules in the config
+func New(store Store, cfg config.Config) *Limiter {
+	account := Rule{
+		FreeAttempts:    cfg.THROTTLE_FREE_ATTEMPTS,
+		MaxFailures:     cfg.LOCKOUT_MAX_FAILURES,
+		Window:          cfg.LOCKOUT_WINDOW,
+		BaseDelay:       cfg.THROTTLE_BASE_DELAY,
+		MaxDelay:        cfg.THROTTLE_MAX_DELAY,
+		LockoutDuration: cfg.LOCKOUT_DURATION,
+	}
+	ip := account
+	ip.MaxFailures = cfg.THROTTLE_IP_MAX_FAILURES
+
+	return &Limiter{
+		store: store,
+		rules: map[string]Rule{KindAccount: account, KindIP: ip},
+		now:   time.Now,
+	}
+}
+
+// AccountKey is the key failures against a single account are counted under.
+// scope separates counters, for example password and OTP attempts.
+func AccountKey(scope, id string) string {
+	return KindAccount + ":" + scope + ":" + id
+}
+
+// IPKey is the key failures from a client address are counted under
+func IPKey(scope, ip string) string {
+	return KindIP + ":" + scope + ":" + ip
+}
+
+func (l *Limiter) rule(key string) Rule {
+	for kind, rule := range l.rules {
+		if strings.HasPrefix(key, kind+":") {
+			return rule
+		}
+	}
+	return l.rules[KindAccount]
+}
+
+// Check returns a *BlockedError if any of the keys may not be tried yet.
+// Empty keys are ignored.
+func (l *Limiter) Check(ctx context.Context, keys ...string) error {
+	now := l.now()
+	var blocked *BlockedError
+
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		record, err := l.store.Get(ctx, key)
+		if err != nil {
+			return err
+		}
+		if wait := record.BlockedUntil.Sub(now); wait > 0 && (blocked == nil || wait > blocked.RetryAfter) {
+			blocked = &BlockedError{Key: key, RetryAfter: wait}
+		}
+	}
+
+	if blocked != nil {
+		return blocked
+	}
+	return nil
+}
+
+// Fail records a failed attempt against every key, extending the backoff or
+// locking the key out once it has failed too often
+func (l *Limiter) Fail(ctx context.Context, keys ...string) error {
+	now := l.now()
+
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		rule := l.rule(key)
+		_, err := l.store.Update(ctx, key, func(r *Record) {
+			// Failures older than the window are forgotten
+			if rule.Window > 0 && now.Sub(r.LastFailure) > rule.Window {
+				r.Failures = 0
+			}
+			r.Failures++
+			r.LastFailure = now
+
+			if delay := rule.delay(r.Failures); delay > 0 {
+				r.BlockedUntil = now.Add(delay)
+			}
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// delay is how long a key has to wait after its nth failure
+func (r Rule) delay(failures int) time.Duration {
+	if r.MaxFailures > 0 && failures >= r.MaxFailures {
+		return r.LockoutDuration
+	}
+	if failures <= r.FreeAttempts || r.BaseDelay <= 0 {
+		return 0
+	}
+
+	// Capped so the multiplication can't overflow
+	exponent := math.Min(float64(failures-r.FreeAttempts-1), 30)
+	delay := time.Duration(float64(r.BaseDelay) * math.Pow(2, exponent))
+	if r.MaxDelay > 0 && delay > r.MaxDelay {
+		delay = r.MaxDelay
+	}
+	return delay
+}
+
+// Reset forgets the failures recorded against the keys, for example after a
+// successful login or when an administrator unlocks an account
+func (l *Limiter) Reset(ctx context.Context, keys ...string) error {
+	for _, key := range keys {
+		if key == "" {
+			continue
+		}
+		if err := l.store.Delete(ctx, key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package throttle
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/lerryjay/auth-grpc-service/pkg/config"
+)
+
+var testConfig = config.Config{
+	THROTTLE_FREE_ATTEMPTS:   3,
+	THROTTLE_BASE_DELAY:      time.Second,
+	THROTTLE_MAX_DELAY:       8 * time.Second,
+	LOCKOUT_MAX_FAILURES:     10,
+	THROTTLE_IP_MAX_FAILURES: 50,
+	LOCKOUT_WINDOW:           15 * time.Minute,
+	LOCKOUT_DURATION:         time.Hour,
+}
+
+// newTestLimiter returns a limiter on a memory store whose clock only moves
+// when the test advances it
+func newTestLimiter() (*Limiter, *MemoryStore, func(time.Duration)) {
+	store := NewMemoryStore(time.Hour)
+	l := New(store, testConfig)
+	now := time.Date(2024, 1, 1, 12, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+	return l, store, func(d time.Duration) { now = now.Add(d) }
+}
+
+// retryAfter returns how long Check says to wait, or zero if the keys may be tried
+func retryAfter(t *testing.T, l *Limiter, keys ...string) time.Duration {
+	t.Helper()
+	err := l.Check(context.Background(), keys...)
+	if err == nil {
+		return 0
+	}
+	var blocked *BlockedError
+	if !errors.As(err, &blocked) {
+		t.Fatalf("got %v, want a *BlockedError", err)
+	}
+	if !errors.Is(err, ErrBlocked) {
+		t.Errorf("got %v, want it to wrap ErrBlocked", err)
+	}
+	return blocked.RetryAfter
+}
+
+func fail(t *testing.T, l *Limiter, times int, keys ...string) {
+	t.Helper()
+	for i := 0; i < times; i++ {
+		if err := l.Fail(context.Background(), keys...); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func TestLimiterBackoffAndLockout(t *testing.T) {
+	account := AccountKey("login", "user-1")
+	ip := IPKey("login", "203.0.113.7")
+
+	tests := []struct {
+		name     string
+		key      string
+		failures int
+		// Time passed after the last failure before checking
+		wait time.Duration
+		want time.Duration
+	}{
+		{"no failures", account, 0, 0, 0},
+		{"within the free attempts", account, 3, 0, 0},
+		{"first delay", account, 4, 0, time.Second},
+		{"delay doubles", account, 5, 0, 2 * time.Second},
+		{"delay doubles again", account, 6, 0, 4 * time.Second},
+		{"delay is capped", account, 9, 0, 8 * time.Second},
+		{"delay counts down", account, 5, time.Second, time.Second},
+		{"delay has passed", account, 5, 2 * time.Second, 0},
+		{"locked out at max failures", account, 10, 0, time.Hour},
+		{"lockout counts down", account, 10, 20 * time.Minute, 40 * time.Minute},
+		{"lockout has passed", account, 10, time.Hour, 0},
+		{"addresses have their own max failures", ip, 10, 0, 8 * time.Second},
+		{"addresses are locked out at theirs", ip, 50, 0, time.Hour},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			l, _, advance := newTestLimiter()
+			fail(t, l, tt.failures, tt.key)
+			advance(tt.wait)
+
+			if got := retryAfter(t, l, tt.key); got != tt.want {
+				t.Errorf("got retry after %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLimiterWindow(t *testing.T) {
+	l, store, advance := newTestLimiter()
+	key := AccountKey("login", "user-1")
+
+	fail(t, l, 9, key)
+	advance(16 * time.Minute)
+	fail(t, l, 1, key)
+
+	record, err := store.Get(context.Background(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if record.Failures != 1 {
+		t.Errorf("got %d failures, want the old ones forgotten", record.Failures)
+	}
+	if got := retryAfter(t, l, key); got != 0 {
+		t.Errorf("got retry after %v, want no delay", got)
+	}
+}
+
+func TestLimiterReset(t *testing.T) {
+	l, store, _ := newTestLimiter()
+	account := AccountKey("login", "user-1")
+	other := AccountKey("login", "user-2")
+
+	fail(t, l, 10, account)
+	fail(t, l, 10, other)
+	if got := retryAfter(t, l, account); got != time.Hour {
+		t.Fatalf("got retry after %v, want the account locked out", got)
+	}
+
+	if err := l.Reset(context.Background(), account, ""); err != nil {
+		t.Fatal(err)
+	}
+	if got := retryAfter(t, l, account); got != 0 {
+		t.Errorf("got retry after %v, want the lockout cleared", got)
+	}
+	if record, _ := store.Get(context.Background(), account); record.Failures != 0 {
+		t.Errorf("got %d failures, want none", record.Failures)
+	}
+	if got := retryAfter(t, l, other); got != time.Hour {
+		t.Errorf("got retry after %v, want other keys still locked out", got)
+	}
+}
+
+func TestLimiterChecksEveryKey(t *testing.T) {
+	l, _, advance := newTestLimiter()
+	account := AccountKey("login", "user-1")
+	ip := IPKey("login", "203.0.113.7")
+
+	fail(t, l, 4, account, ip)
+	advance(500 * time.Millisecond)
+	fail(t, l, 1, ip)
+
+	// The longest wait wins
+	if got := retryAfter(t, l, "", account, ip); got != 2*time.Second {
+		t.Errorf("got retry after %v, want the address's delay", got)
+	}
+}
+
+func TestMemoryStoreSweep(t *testing.T) {
+	ctx := context.Background()
+	store := NewMemoryStore(time.Minute)
+	now := time.Now()
+
+	set := func(key string, record Record) {
+		if _, err := store.Update(ctx, key, func(r *Record) { *r = record }); err != nil {
+			t.Fatal(err)
+		}
+	}
+	set("stale", Record{Failures: 3, LastFailure: now.Add(-time.Hour)})
+	set("locked", Record{Failures: 10, LastFailure: now.Add(-time.Hour), BlockedUntil: now.Add(time.Hour)})
+	set("recent", Record{Failures: 1, LastFailure: now})
+
+	// Sweeps run at most once per ttl, so pretend the last one was long ago
+	store.swept = time.Time{}
+	set("trigger", Record{Failures: 1, LastFailure: now})
+
+	for key, want := range map[string]int{"stale": 0, "locked": 10, "recent": 1, "trigger": 1} {
+		record, err := store.Get(ctx, key)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if record.Failures != want {
+			t.Errorf("%s: got %d failures, want %d", key, record.Failures, want)
+		}
+	}
+}
@@ -0,0 +1,399 @@
+// Package webauthn implements the relying party checks of the WebAuthn
+// registration and authentication ceremonies for passkeys.
+//
+// Credentials are requested with attestation "none" and every credential is
+// treated as unattested, so attestation statements are not verified. Only
+// ES256 and RS256 credential keys are supported.
+package webauthn
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/lerryjay/auth-grpc-service/pkg/config"
+)
+
+// COSE algorithm identifiers
+const (
+	AlgES256 = -7
+	AlgRS256 = -257
+)
+
+// Client data types for each ceremony
+const (
+	TypeCreate = "webauthn.create"
+	TypeGet    = "webauthn.get"
+)
+
+// Authenticator data flags
+const (
+	flagUserPresent  = 0x01
+	flagUserVerified = 0x04
+	flagAttested     = 0x40
+	flagExtensions   = 0x80
+)
+
+var (
+	// ErrInvalidResponse is returned when the authenticator response fails any check
+	ErrInvalidResponse = errors.New("invalid authenticator response")
+	// ErrUnsupportedKey is returned for credential keys other than ES256 and RS256
+	ErrUnsupportedKey = errors.New("unsupported credential public key")
+	// ErrSignCount is returned when the sign count went backwards, which
+	// suggests the authenticator has been cloned
+	ErrSignCount = errors.New("credential sign count did not increase")
+)
+
+func invalid(format string, args ...interface{}) error {
+	return fmt.Errorf("%w: %s", ErrInvalidResponse, fmt.Sprintf(format, args...))
+}
+
+// RelyingParty holds the identity the ceremonies are bound to
+type RelyingParty struct {
+	// Effective domain credentials are scoped to, e.g. example.com
+	ID   string
+	Name string
+	// Origins the browser may report in the client data, e.g. https://example.com
+	Origins []string
+	// Require the authenticator to verify the user with a PIN or biometric
+	RequireUserVerification bool
+	// How long the user has to complete a ceremony
+	Timeout time.Duration
+}
+
+// New creates the relying party from the config. The id and origin default
+// to the host and origin of APP_URL.
+func New(cfg config.Config) (*RelyingParty, error) {
+	rp := &RelyingParty{
+		ID:      cfg.WEBAUTHN_RP_ID,
+		Name:    cfg.APP_NAME,
+		Timeout: cfg.WEBAUTHN_TIMEOUT,
+	}
+
+	for _, origin := range strings.Split(cfg.WEBAUTHN_ORIGINS, ",") {
+		if origin = strings.TrimSpace(origin); origin != "" {
+			rp.Origins = append(rp.Origins, origin)
+		}
+	}
+
+	if rp.ID == "" || len(rp.Origins) == 0 {
+		appURL, err := url.Parse(cfg.APP_URL)
+		if err != nil || appURL.Host == "" {
+			return nil, errors.New("set WEBAUTHN_RP_ID and WEBAUTHN_ORIGINS or a valid APP_URL")
+		}
+		if rp.ID == "" {
+			rp.ID = appURL.Hostname()
+		}
+		if len(rp.Origins) == 0 {
+			rp.Origins = []string{appURL.Scheme + "://" + appURL.Host}
+		}
+	}
+	if rp.Timeout == 0 {
+		rp.Timeout = 5 * time.Minute
+	}
+
+	return rp, nil
+}
+
+// ClientData is the part of clientDataJSON the relying party checks
+type ClientData struct {
+	Type        string `json:"type"`
+	Challenge   string `json:"challenge"`
+	Origin      string `json:"origin"`
+	CrossOrigin bool   `json:"crossOrigin,omitempty"`
+}
+
+// ParseClientData decodes clientDataJSON and returns the challenge it signs
+// after checking the ceremony type and origin
+func (rp *RelyingParty) ParseClientData(clientDataJSON []byte, ceremony string) ([]byte, error) {
+	var data ClientData
+	if err := json.Unmarshal(clientDataJSON, &data); err != nil {
+		return nil, invalid("client data is not valid JSON")
+	}
+	if data.Type != ceremony {
+		return nil, invalid("client data type is %q", data.Type)
+	}
+	if data.CrossOrigin {
+		return nil, invalid("cross origin requests are not allowed")
+	}
+	if !rp.allowedOrigin(data.Origin) {
+		return nil, invalid("origin %q is not allowed", data.Origin)
+	}
+
+	challenge, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(data.Challenge, "="))
+	if err != nil || len(challenge) == 0 {
+		return nil, invalid("challenge is not base64url")
+	}
+	return challenge, nil
+}
+
+func (rp *RelyingParty) allowedOrigin(origin string) bool {
+	u, err := url.Parse(origin)
+	if err != nil {
+		return false
+	}
+	for _, allowed := range rp.Origins {
+		if strings.EqualFold(strings.TrimRight(allowed, "/"), u.Scheme+"://"+u.Host) {
+			return true
+		}
+	}
+	return false
+}
+
+// AuthenticatorData is the parsed authenticator data
+type AuthenticatorData struct {
+	RPIDHash     []byte
+	Flags        byte
+	SignCount    uint32
+	AAGUID       []byte
+	CredentialID []byte
+	// COSE encoded credential public key, only present after registration
+	PublicKey []byte
+}
+
+// ParseAuthenticatorData decodes the binary authenticator data
+func ParseAuthenticatorData(data []byte) (*AuthenticatorData, error) {
+	if len(data) < 37 {
+		return nil, invalid("authenticator data is too short")
+	}
+	ad := &AuthenticatorData{
+		RPIDHash:  data[:32],
+		Flags:     data[32],
+		SignCount: binary.BigEndian.Uint32(data[33:37]),
+	}
+
+	rest := data[37:]
+	if ad.Flags&flagAttested != 0 {
+		if len(rest) < 18 {
+			return nil, invalid("attested credential data is too short")
+		}
+		ad.AAGUID = rest[:16]
+		idLen := int(binary.BigEndian.Uint16(rest[16:18]))
+		rest = rest[18:]
+		if len(rest) < idLen {
+			return nil, invalid("credential id is truncated")
+		}
+		ad.CredentialID = rest[:idLen]
+		rest = rest[idLen:]
+
+		var key cbor.RawMessage
+		remaining, err := cbor.UnmarshalFirst(rest, &key)
+		if err != nil {
+			return nil, invalid("credential public key is not CBOR")
+		}
+		ad.PublicKey = []byte(key)
+		rest = remaining
+	}
+	if ad.Flags&flagExtensions != 0 {
+		var extensions cbor.RawMessage
+		remaining, err := cbor.UnmarshalFirst(rest, &extensions)
+		if err != nil {
+			return nil, invalid("extensions are not CBOR")
+		}
+		rest = remaining
+	}
+	if len(rest) != 0 {
+		return nil, invalid("unexpected trailing authenticator data")
+	}
+
+	return ad, nil
+}
+
+// check verifies the parts of the authenticator data common to both ceremonies
+func (rp *RelyingParty) check(ad *AuthenticatorData) error {
+	rpIDHash := sha256.Sum256([]byte(rp.ID))
+	if subtle.ConstantTimeCompare(ad.RPIDHash, rpIDHash[:]) != 1 {
+		return invalid("credential is scoped to another relying party")
+	}
+	if ad.Flags&flagUserPresent == 0 {
+		return invalid("user was not present")
+	}
+	if rp.RequireUserVerification && ad.Flags&flagUserVerified == 0 {
+		return invalid("user was not verified")
+	}
+	return nil
+}
+
+// UserVerified reports whether the authenticator verified the user
+func (ad *AuthenticatorData) UserVerified() bool {
+	return ad.Flags&flagUserVerified != 0
+}
+
+type attestationObject struct {
+	Format   string          `cbor:"fmt"`
+	AuthData []byte          `cbor:"authData"`
+	AttStmt  cbor.RawMessage `cbor:"attStmt"`
+}
+
+// Credential is a newly registered credential
+type Credential struct {
+	ID        []byte
+	PublicKey []byte
+	SignCount uint32
+	AAGUID    []byte
+}
+
+// VerifyRegistration checks the response to a create() call and returns the
+// new credential along with the challenge it answered. The caller must check
+// that the challenge is one it issued.
+func (rp *RelyingParty) VerifyRegistration(clientDataJSON, attestation []byte) (*Credential, []byte, error) {
+	challenge, err := rp.ParseClientData(clientDataJSON, TypeCreate)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var obj attestationObject
+	if err := cbor.Unmarshal(attestation, &obj); err != nil {
+		return nil, nil, invalid("attestation object is not CBOR")
+	}
+
+	ad, err := ParseAuthenticatorData(obj.AuthData)
+	if err != nil {
+		return nil, nil, err
+	}
+	if err := rp.check(ad); err != nil {
+		return nil, nil, err
+	}
+	if ad.Flags&flagAttested == 0 || len(ad.CredentialID) == 0 {
+		return nil, nil, invalid("no credential was created")
+	}
+	if _, err := ParsePublicKey(ad.PublicKey); err != nil {
+		return nil, nil, err
+	}
+
+	return &Credential{
+		ID:        ad.CredentialID,
+		PublicKey: ad.PublicKey,
+		SignCount: ad.SignCount,
+		AAGUID:    ad.AAGUID,
+	}, challenge, nil
+}
+
+// Assertion is the response to a get() call
+type Assertion struct {
+	ClientDataJSON    []byte
+	AuthenticatorData []byte
+	Signature         []byte
+}
+
+// AssertionResult is what a verified assertion tells the relying party
+type AssertionResult struct {
+	// The challenge the assertion answered. The caller must check that it
+	// is one it issued.
+	Challenge    []byte
+	SignCount    uint32
+	UserVerified bool
+}
+
+// VerifyAssertion checks an assertion made with the stored public key
+func (rp *RelyingParty) VerifyAssertion(a Assertion, publicKey []byte, storedSignCount uint32) (*AssertionResult, error) {
+	challenge, err := rp.ParseClientData(a.ClientDataJSON, TypeGet)
+	if err != nil {
+		return nil, err
+	}
+
+	ad, err := ParseAuthenticatorData(a.AuthenticatorData)
+	if err != nil {
+		return nil, err
+	}
+	if err := rp.check(ad); err != nil {
+		return nil, err
+	}
+
+	key, err := ParsePublicKey(publicKey)
+	if err != nil {
+		return nil, err
+	}
+	clientDataHash := sha256.Sum256(a.ClientDataJSON)
+	signed := append(append([]byte{}, a.AuthenticatorData...), clientDataHash[:]...)
+	if err := verifySignature(key, signed, a.Signature); err != nil {
+		return nil, err
+	}
+
+	// Authenticators that don't keep a counter always report zero
+	if (ad.SignCount != 0 || storedSignCount != 0) && ad.SignCount <= storedSignCount {
+		return nil, ErrSignCount
+	}
+
+	return &AssertionResult{
+		Challenge:    challenge,
+		SignCount:    ad.SignCount,
+		UserVerified: ad.UserVerified(),
+	}, nil
+}
+
+func verifySignature(key crypto.PublicKey, signed, signature []byte) error {
+	digest := sha256.Sum256(signed)
+	switch k := key.(type) {
+	case *ecdsa.PublicKey:
+		if !ecdsa.VerifyASN1(k, digest[:], signature) {
+			return invalid("signature is invalid")
+		}
+	case *rsa.PublicKey:
+		if err := rsa.VerifyPKCS1v15(k, crypto.SHA256, digest[:], signature); err != nil {
+			return invalid("signature is invalid")
+		}
+	default:
+		return ErrUnsupportedKey
+	}
+	return nil
+}
+
+type coseKey struct {
+	Kty int64           `cbor:"1,keyasint"`
+	Alg int64           `cbor:"3,keyasint"`
+	P1  cbor.RawMessage `cbor:"-1,keyasint"`
+	P2  cbor.RawMessage `cbor:"-2,keyasint"`
+	P3  cbor.RawMessage `cbor:"-3,keyasint"`
+}
+
+// ParsePublicKey decodes a COSE encoded ES256 or RS256 public key
+func ParsePublicKey(cose []byte) (crypto.PublicKey, error) {
+	var key coseKey
+	if err := cbor.Unmarshal(cose, &key); err != nil {
+		return nil, ErrUnsupportedKey
+	}
+
+	switch {
+	case key.Kty == 2 && key.Alg == AlgES256:
+		var crv int64
+		var x, y []byte
+		if cbor.Unmarshal(key.P1, &crv) != nil || cbor.Unmarshal(key.P2, &x) != nil || cbor.Unmarshal(key.P3, &y) != nil {
+			return nil, ErrUnsupportedKey
+		}
+		// P-256
+		if crv != 1 || len(x) != 32 || len(y) != 32 {
+			return nil, ErrUnsupportedKey
+		}
+		pub := &ecdsa.PublicKey{Curve: elliptic.P256(), X: new(big.Int).SetBytes(x), Y: new(big.Int).SetBytes(y)}
+		if !pub.Curve.IsOnCurve(pub.X, pub.Y) {
+			return nil, ErrUnsupportedKey
+		}
+		return pub, nil
+	case key.Kty == 3 && key.Alg == AlgRS256:
+		var n, e []byte
+		if cbor.Unmarshal(key.P1, &n) != nil || cbor.Unmarshal(key.P2, &e) != nil {
+			return nil, ErrUnsupportedKey
+		}
+		exponent := new(big.Int).SetBytes(e)
+		if len(n) < 256 || !exponent.IsInt64() || exponent.Int64() > 1<<31-1 {
+			return nil, ErrUnsupportedKey
+		}
+		return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(exponent.Int64())}, nil
+	}
+
+	return nil, ErrUnsupportedKey
+}
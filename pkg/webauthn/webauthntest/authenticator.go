@@ -0,0 +1,126 @@
+// Package webauthntest provides a software authenticator that produces the
+// same responses a browser would, so the passkey ceremonies can be exercised
+// without one.
+package webauthntest
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/json"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/lerryjay/auth-grpc-service/pkg/webauthn"
+)
+
+// Authenticator holds a single ES256 credential
+type Authenticator struct {
+	// Origin reported in the client data
+	Origin string
+	// RPID the credential is scoped to
+	RPID string
+	// Set the user verified flag in every response
+	UserVerified bool
+
+	CredentialID []byte
+	SignCount    uint32
+	key          *ecdsa.PrivateKey
+}
+
+// New creates an authenticator with a fresh credential
+func New(rpID, origin string) (*Authenticator, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+	id := make([]byte, 16)
+	if _, err := rand.Read(id); err != nil {
+		return nil, err
+	}
+	return &Authenticator{
+		Origin:       origin,
+		RPID:         rpID,
+		UserVerified: true,
+		CredentialID: id,
+		key:          key,
+	}, nil
+}
+
+// Register answers a create() call with a "none" attestation
+func (a *Authenticator) Register(challenge []byte) (clientDataJSON, attestationObject []byte, err error) {
+	clientDataJSON, err = a.clientData(webauthn.TypeCreate, challenge)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	publicKey, err := cbor.Marshal(map[int]interface{}{
+		1:  2,
+		3:  webauthn.AlgES256,
+		-1: 1,
+		-2: a.key.X.FillBytes(make([]byte, 32)),
+		-3: a.key.Y.FillBytes(make([]byte, 32)),
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authData := a.authData(0x40)
+	// AAGUID of all zeros, then the credential id and key
+	authData = append(authData, make([]byte, 16)...)
+	authData = binary.BigEndian.AppendUint16(authData, uint16(len(a.CredentialID)))
+	authData = append(authData, a.CredentialID...)
+	authData = append(authData, publicKey...)
+
+	attestationObject, err = cbor.Marshal(map[string]interface{}{
+		"fmt":      "none",
+		"attStmt":  map[string]interface{}{},
+		"authData": authData,
+	})
+	return clientDataJSON, attestationObject, err
+}
+
+// Assert answers a get() call, incrementing the sign count
+func (a *Authenticator) Assert(challenge []byte) (*webauthn.Assertion, error) {
+	clientDataJSON, err := a.clientData(webauthn.TypeGet, challenge)
+	if err != nil {
+		return nil, err
+	}
+
+	a.SignCount++
+	authData := a.authData(0)
+
+	clientDataHash := sha256.Sum256(clientDataJSON)
+	digest := sha256.Sum256(append(append([]byte{}, authData...), clientDataHash[:]...))
+	signature, err := ecdsa.SignASN1(rand.Reader, a.key, digest[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return &webauthn.Assertion{
+		ClientDataJSON:    clientDataJSON,
+		AuthenticatorData: authData,
+		Signature:         signature,
+	}, nil
+}
+
+func (a *Authenticator) clientData(ceremony string, challenge []byte) ([]byte, error) {
+	return json.Marshal(webauthn.ClientData{
+		Type:      ceremony,
+		Challenge: base64.RawURLEncoding.EncodeToString(challenge),
+		Origin:    a.Origin,
+	})
+}
+
+func (a *Authenticator) authData(extraFlags byte) []byte {
+	rpIDHash := sha256.Sum256([]byte(a.RPID))
+	flags := byte(0x01) | extraFlags
+	if a.UserVerified {
+		flags |= 0x04
+	}
+	data := append([]byte{}, rpIDHash[:]...)
+	data = append(data, flags)
+	return binary.BigEndian.AppendUint32(data, a.SignCount)
+}